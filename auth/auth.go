@@ -0,0 +1,105 @@
+// Package auth authenticates the callers of the services. Callers present a
+// bearer token, which the transports put in the request context and the
+// endpoint middleware and gRPC interceptors exchange for the caller Identity
+// before the request reaches the service.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/hykuan/k8s-client-example/errors"
+)
+
+// ErrUnauthorizedAccess indicates missing or invalid credentials provided
+// when accessing a protected resource.
+var ErrUnauthorizedAccess = errors.New(errors.Unauthenticated, "missing or invalid credentials provided")
+
+// ErrWeakSecret indicates a signing secret shorter than MinSecretLength.
+var ErrWeakSecret = errors.New(errors.Invalid, fmt.Sprintf("secret must be at least %d bytes long", MinSecretLength))
+
+// ErrInvalidTTL indicates a request for a token that doesn't expire.
+var ErrInvalidTTL = errors.New(errors.Invalid, "token ttl must be positive")
+
+// MinSecretLength is the least number of bytes of a signing secret, which
+// matches the output size of HMAC-SHA256.
+const MinSecretLength = 32
+
+// Identity describes an authenticated caller.
+type Identity struct {
+	Subject string
+	Groups  []string
+}
+
+// Authenticator issues and verifies caller tokens.
+type Authenticator interface {
+	// Issue returns a token identifying id which expires after ttl. Tokens
+	// must expire, so ttl must be positive.
+	Issue(id Identity, ttl time.Duration) (string, error)
+
+	// Identify returns the identity a token was issued for.
+	Identify(token string) (Identity, error)
+}
+
+type contextKey int
+
+const (
+	tokenKey contextKey = iota
+	identityKey
+)
+
+// WithToken returns a copy of ctx carrying the caller token.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey, token)
+}
+
+// TokenFromContext returns the caller token carried by ctx, if any.
+func TokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(tokenKey).(string)
+	return token
+}
+
+// WithIdentity returns a copy of ctx carrying the caller identity.
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey, id)
+}
+
+// FromContext returns the identity of the authenticated caller, if any.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey).(Identity)
+	return id, ok
+}
+
+// Authenticate identifies the caller by the token carried by ctx and returns
+// a copy of ctx carrying its identity.
+func Authenticate(ctx context.Context, authn Authenticator) (context.Context, error) {
+	token := TokenFromContext(ctx)
+	if token == "" {
+		return ctx, ErrUnauthorizedAccess
+	}
+
+	id, err := authn.Identify(token)
+	if err != nil {
+		return ctx, err
+	}
+
+	return WithIdentity(ctx, id), nil
+}
+
+// Middleware rejects requests that don't carry a valid token, and makes the
+// caller identity available to the endpoint otherwise.
+func Middleware(authn Authenticator) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx, err := Authenticate(ctx, authn)
+			if err != nil {
+				return nil, err
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
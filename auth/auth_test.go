@@ -0,0 +1,204 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/hykuan/k8s-client-example/auth"
+	"github.com/hykuan/k8s-client-example/errors"
+)
+
+const secret = "0123456789abcdef0123456789abcdef"
+
+var identity = auth.Identity{Subject: "alice", Groups: []string{"admins"}}
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		secret string
+		err    error
+	}{
+		"empty secret": {"", auth.ErrWeakSecret},
+		"short secret": {secret[1:], auth.ErrWeakSecret},
+		"long secret":  {secret, nil},
+	}
+
+	for desc, tc := range cases {
+		_, err := auth.New(tc.secret)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", desc, tc.err, err))
+	}
+}
+
+func TestIssue(t *testing.T) {
+	authn, err := auth.New(secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating authenticator: %s", err))
+
+	cases := map[string]struct {
+		id  auth.Identity
+		ttl time.Duration
+		err error
+	}{
+		"issue token":                 {identity, time.Hour, nil},
+		"issue token without subject": {auth.Identity{}, time.Hour, auth.ErrUnauthorizedAccess},
+		"issue token without expiry":  {identity, 0, auth.ErrInvalidTTL},
+		"issue token expired already": {identity, -time.Hour, auth.ErrInvalidTTL},
+	}
+
+	for desc, tc := range cases {
+		_, err := authn.Issue(tc.id, tc.ttl)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", desc, tc.err, err))
+	}
+}
+
+// mint signs payload with secret the way tokens minted by hand would be, so
+// that claims Issue refuses to produce can be tested.
+func mint(payload string) string {
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + base64.RawURLEncoding.EncodeToString([]byte(payload))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestIdentify(t *testing.T) {
+	authn, err := auth.New(secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating authenticator: %s", err))
+
+	valid, err := authn.Issue(identity, time.Hour)
+	require.Nil(t, err, fmt.Sprintf("unexpected error issuing token: %s", err))
+	expired := mint(`{"sub":"alice","groups":["admins"],"iat":1,"exp":2}`)
+	eternal := mint(`{"sub":"alice","groups":["admins"],"iat":1}`)
+	other, err := auth.New(strings.Repeat("x", auth.MinSecretLength))
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating authenticator: %s", err))
+	foreign, err := other.Issue(identity, time.Hour)
+	require.Nil(t, err, fmt.Sprintf("unexpected error issuing token: %s", err))
+
+	parts := strings.Split(valid, ".")
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." + parts[1] + "."
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"root"}`)) + "." + parts[2]
+
+	cases := map[string]struct {
+		token string
+		err   error
+	}{
+		"valid token":                 {valid, nil},
+		"token without expiry":        {eternal, auth.ErrUnauthorizedAccess},
+		"expired token":               {expired, auth.ErrUnauthorizedAccess},
+		"token signed with other key": {foreign, auth.ErrUnauthorizedAccess},
+		"unsigned token":              {unsigned, auth.ErrUnauthorizedAccess},
+		"tampered token":              {tampered, auth.ErrUnauthorizedAccess},
+		"malformed token":             {"token", auth.ErrUnauthorizedAccess},
+	}
+
+	for desc, tc := range cases {
+		id, err := authn.Identify(tc.token)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, identity, id, fmt.Sprintf("%s: expected %v got %v", desc, identity, id))
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	authn, err := auth.New(secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating authenticator: %s", err))
+	token, err := authn.Issue(identity, time.Hour)
+	require.Nil(t, err, fmt.Sprintf("unexpected error issuing token: %s", err))
+
+	endpoint := auth.Middleware(authn)(func(ctx context.Context, _ interface{}) (interface{}, error) {
+		id, _ := auth.FromContext(ctx)
+		return id, nil
+	})
+
+	cases := map[string]struct {
+		header string
+		err    error
+	}{
+		"bearer token":      {"Bearer " + token, nil},
+		"lower case scheme": {"bearer " + token, nil},
+		"upper case scheme": {"BEARER " + token, nil},
+		"missing header":    {"", auth.ErrUnauthorizedAccess},
+		"other scheme":      {"Basic " + token, auth.ErrUnauthorizedAccess},
+		"invalid token":     {"Bearer token", auth.ErrUnauthorizedAccess},
+		"empty bearer auth": {"Bearer ", auth.ErrUnauthorizedAccess},
+	}
+
+	for desc, tc := range cases {
+		r, err := http.NewRequest(http.MethodGet, "/pv", nil)
+		require.Nil(t, err, fmt.Sprintf("unexpected error creating request: %s", err))
+		r.Header.Set("Authorization", tc.header)
+
+		id, err := endpoint(auth.HTTPToContext(context.Background(), r), nil)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, identity, id, fmt.Sprintf("%s: expected %v got %v", desc, identity, id))
+		}
+	}
+
+	assert.Equal(t, errors.Unauthenticated, errors.CodeOf(auth.ErrUnauthorizedAccess))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	authn, err := auth.New(secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating authenticator: %s", err))
+	token, err := authn.Issue(identity, time.Hour)
+	require.Nil(t, err, fmt.Sprintf("unexpected error issuing token: %s", err))
+
+	interceptor := auth.UnaryServerInterceptor(authn)
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		id, _ := auth.FromContext(ctx)
+		return id, nil
+	}
+
+	cases := map[string]struct {
+		md   metadata.MD
+		code codes.Code
+	}{
+		"bearer token":     {metadata.Pairs("authorization", "Bearer "+token), codes.OK},
+		"missing metadata": {nil, codes.Unauthenticated},
+		"invalid token":    {metadata.Pairs("authorization", "Bearer token"), codes.Unauthenticated},
+	}
+
+	for desc, tc := range cases {
+		ctx := context.Background()
+		if tc.md != nil {
+			ctx = metadata.NewIncomingContext(ctx, tc.md)
+		}
+
+		id, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		assert.Equal(t, tc.code, status.Code(err), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, status.Code(err)))
+		if tc.code == codes.OK {
+			assert.Equal(t, identity, id, fmt.Sprintf("%s: expected %v got %v", desc, identity, id))
+		}
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := auth.UnaryClientInterceptor()
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := interceptor(auth.WithToken(context.Background(), "token"), "/method", nil, nil, nil, invoker)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, []string{"Bearer token"}, md.Get("authorization"), "caller token not forwarded")
+
+	err = interceptor(context.Background(), "/method", nil, nil, nil, invoker)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Empty(t, md.Get("authorization"), "token forwarded without a caller")
+}
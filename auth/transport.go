@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hykuan/k8s-client-example/errors"
+)
+
+const (
+	bearerPrefix = "Bearer "
+	metadataKey  = "authorization"
+)
+
+// HTTPToContext moves the bearer token of the HTTP Authorization header to
+// the request context.
+func HTTPToContext(ctx context.Context, r *http.Request) context.Context {
+	return withBearer(ctx, r.Header.Get("Authorization"))
+}
+
+// UnaryServerInterceptor rejects gRPC calls whose metadata doesn't carry a
+// valid token.
+func UnaryServerInterceptor(authn Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticateGRPC(ctx, authn)
+		if err != nil {
+			return nil, errors.ToStatus(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects gRPC streams whose metadata doesn't carry a
+// valid token.
+func StreamServerInterceptor(authn Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticateGRPC(stream.Context(), authn)
+		if err != nil {
+			return errors.ToStatus(err)
+		}
+
+		return handler(srv, &authenticatedStream{stream, ctx})
+	}
+}
+
+// UnaryClientInterceptor forwards the caller token carried by the context of
+// outgoing gRPC calls, so that downstream services see the original caller.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoing(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor forwards the caller token carried by the context of
+// outgoing gRPC streams.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoing(ctx), desc, cc, method, opts...)
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticateGRPC(ctx context.Context, authn Authenticator) (context.Context, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(metadataKey); len(values) > 0 {
+			ctx = withBearer(ctx, values[0])
+		}
+	}
+
+	return Authenticate(ctx, authn)
+}
+
+func outgoing(ctx context.Context) context.Context {
+	token := TokenFromContext(ctx)
+	if token == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, metadataKey, bearerPrefix+token)
+}
+
+// withBearer puts the token of a bearer Authorization value in ctx. The
+// scheme is case-insensitive, as RFC 6750 defines it.
+func withBearer(ctx context.Context, value string) context.Context {
+	if len(value) < len(bearerPrefix) || !strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return ctx
+	}
+
+	return WithToken(ctx, strings.TrimSpace(value[len(bearerPrefix):]))
+}
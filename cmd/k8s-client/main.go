@@ -16,13 +16,13 @@ import (
 	"github.com/qeek-dev/quaistudio"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
 
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/auth"
 	"github.com/hykuan/k8s-client-example/k8s-client"
 	"github.com/hykuan/k8s-client-example/k8s-client/api"
 	grpcapi "github.com/hykuan/k8s-client-example/k8s-client/api/grpc"
@@ -34,15 +34,18 @@ const (
 	defLogLevel   = "info"
 	defHTTPPort   = "8180"
 	defGRPCPort   = "8181"
-	defSecret     = "users"
 	defServerCert = ""
 	defServerKey  = ""
+	defNamespace  = "default"
+	defPolicyFile = ""
 	envLogLevel   = "QS_USERS_LOG_LEVEL"
 	envHTTPPort   = "QS_USERS_HTTP_PORT"
 	envGRPCPort   = "QS_USERS_GRPC_PORT"
 	envSecret     = "QS_USERS_SECRET"
 	envServerCert = "QS_USERS_SERVER_CERT"
 	envServerKey  = "QS_USERS_SERVER_KEY"
+	envNamespace  = "QS_K8S_NAMESPACE"
+	envPolicyFile = "QS_K8S_POLICY_FILE"
 )
 
 type config struct {
@@ -52,6 +55,8 @@ type config struct {
 	secret     string
 	serverCert string
 	serverKey  string
+	namespace  string
+	policyFile string
 }
 
 func main() {
@@ -78,17 +83,22 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	mc, err := metrics.NewForConfig(config)
-	list, err := mc.MetricsV1beta1().NodeMetricses().List(metav1.ListOptions{})
-	if len(list.Items) == 0 {
+	metricsClient, err := metrics.NewForConfig(config)
+	if err != nil {
 		panic(err)
 	}
 
-	svc := newService(clientset, logger)
+	policy := loadPolicy(cfg.policyFile, logger)
+	authn, err := auth.New(cfg.secret)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to create authenticator, set %s: %s", envSecret, err))
+		os.Exit(1)
+	}
+	svc := newService(clientset, metricsClient, cfg.namespace, policy, logger)
 	errs := make(chan error, 2)
 
-	go startHTTPServer(svc, cfg.httpPort, cfg.serverCert, cfg.serverKey, logger, errs)
-	go startGRPCServer(svc, cfg.grpcPort, cfg.serverCert, cfg.serverKey, logger, errs)
+	go startHTTPServer(svc, authn, cfg.httpPort, cfg.serverCert, cfg.serverKey, logger, errs)
+	go startGRPCServer(svc, authn, cfg.grpcPort, cfg.serverCert, cfg.serverKey, logger, errs)
 
 	go func() {
 		c := make(chan os.Signal)
@@ -105,14 +115,33 @@ func loadConfig() config {
 		logLevel:   quaistudio.Env(envLogLevel, defLogLevel),
 		httpPort:   quaistudio.Env(envHTTPPort, defHTTPPort),
 		grpcPort:   quaistudio.Env(envGRPCPort, defGRPCPort),
-		secret:     quaistudio.Env(envSecret, defSecret),
+		secret:     quaistudio.Env(envSecret, ""),
 		serverCert: quaistudio.Env(envServerCert, defServerCert),
 		serverKey:  quaistudio.Env(envServerKey, defServerKey),
+		namespace:  quaistudio.Env(envNamespace, defNamespace),
+		policyFile: quaistudio.Env(envPolicyFile, defPolicyFile),
 	}
 }
 
-func newService(clientSet *kubernetes.Clientset, logger logger.Logger) k8s_client.Service {
-	svc := k8s_client.New(clientSet)
+// loadPolicy reads the authorization policy. Without one, every request is
+// denied.
+func loadPolicy(path string, logger logger.Logger) api.Policy {
+	if path == "" {
+		logger.Warn(fmt.Sprintf("No authorization policy configured, set %s to allow requests", envPolicyFile))
+		return api.Policy{}
+	}
+
+	policy, err := api.LoadPolicy(path)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to load authorization policy: %s", err))
+		os.Exit(1)
+	}
+	return policy
+}
+
+func newService(clientSet *kubernetes.Clientset, metricsClient *metrics.Clientset, namespace string, policy api.Policy, logger logger.Logger) k8s_client.Service {
+	svc := k8s_client.New(clientSet, metricsClient, namespace)
+	svc = api.AuthorizationMiddleware(svc, policy, namespace)
 	svc = api.LoggingMiddleware(svc, logger)
 	svc = api.MetricsMiddleware(
 		svc,
@@ -132,24 +161,29 @@ func newService(clientSet *kubernetes.Clientset, logger logger.Logger) k8s_clien
 	return svc
 }
 
-func startHTTPServer(svc k8s_client.Service, port string, certFile string, keyFile string, logger logger.Logger, errs chan error) {
+func startHTTPServer(svc k8s_client.Service, authn auth.Authenticator, port string, certFile string, keyFile string, logger logger.Logger, errs chan error) {
 	p := fmt.Sprintf(":%s", port)
 	if certFile != "" || keyFile != "" {
 		logger.Info(fmt.Sprintf("k8s-client service started using https, cert %s key %s, exposed port %s", certFile, keyFile, port))
-		errs <- http.ListenAndServeTLS(p, certFile, keyFile, httpapi.MakeHandler(svc, logger))
+		errs <- http.ListenAndServeTLS(p, certFile, keyFile, httpapi.MakeHandler(svc, authn, logger))
 	} else {
 		logger.Info(fmt.Sprintf("k8s-client service started using http, exposed port %s", port))
-		errs <- http.ListenAndServe(p, httpapi.MakeHandler(svc, logger))
+		errs <- http.ListenAndServe(p, httpapi.MakeHandler(svc, authn, logger))
 	}
 }
 
-func startGRPCServer(svc k8s_client.Service, port string, certFile string, keyFile string, logger logger.Logger, errs chan error) {
+func startGRPCServer(svc k8s_client.Service, authn auth.Authenticator, port string, certFile string, keyFile string, logger logger.Logger, errs chan error) {
 	p := fmt.Sprintf(":%s", port)
 	listener, err := net.Listen("tcp", p)
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to listen on port %s: %s", port, err))
 	}
 
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(auth.UnaryServerInterceptor(authn)),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor(authn)),
+	}
+
 	var server *grpc.Server
 	if certFile != "" || keyFile != "" {
 		creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
@@ -158,10 +192,10 @@ func startGRPCServer(svc k8s_client.Service, port string, certFile string, keyFi
 			os.Exit(1)
 		}
 		logger.Info(fmt.Sprintf("k8s-client gRPC service started using https on port %s with cert %s key %s", port, certFile, keyFile))
-		server = grpc.NewServer(grpc.Creds(creds))
+		server = grpc.NewServer(append(opts, grpc.Creds(creds))...)
 	} else {
 		logger.Info(fmt.Sprintf("k8s-client gRPC service started using http on port %s", port))
-		server = grpc.NewServer()
+		server = grpc.NewServer(opts...)
 	}
 
 	quai.RegisterK8SClientServiceServer(server, grpcapi.NewServer(svc))
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/auth"
 	k8sapi "github.com/hykuan/k8s-client-example/k8s-client/api/grpc"
 	"github.com/hykuan/k8s-client-example/logger"
 	"github.com/hykuan/k8s-client-example/models"
@@ -28,7 +29,6 @@ const (
 	defLogLevel   = "info"
 	defHTTPPort   = "8182"
 	defGRPCPort   = "8183"
-	defSecret     = "users"
 	defServerCert = ""
 	defServerKey  = ""
 	defK8sUrl     = "localhost:8181"
@@ -59,14 +59,20 @@ func main() {
 		log.Fatalf(err.Error())
 	}
 
+	authn, err := auth.New(cfg.secret)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to create authenticator, set %s: %s", envSecret, err))
+		os.Exit(1)
+	}
+
 	conn := connectToK8sService(cfg.k8sUrl, logger)
 	defer conn.Close()
 
 	svc := newService(conn, logger)
 	errs := make(chan error, 2)
 
-	go startHTTPServer(svc, cfg.httpPort, cfg.serverCert, cfg.serverKey, logger, errs)
-	go startGRPCServer(svc, cfg.grpcPort, cfg.serverCert, cfg.serverKey, logger, errs)
+	go startHTTPServer(svc, authn, cfg.httpPort, cfg.serverCert, cfg.serverKey, logger, errs)
+	go startGRPCServer(svc, authn, cfg.grpcPort, cfg.serverCert, cfg.serverKey, logger, errs)
 
 	go func() {
 		c := make(chan os.Signal)
@@ -83,15 +89,22 @@ func loadConfig() config {
 		logLevel:   quaistudio.Env(envLogLevel, defLogLevel),
 		httpPort:   quaistudio.Env(envHTTPPort, defHTTPPort),
 		grpcPort:   quaistudio.Env(envGRPCPort, defGRPCPort),
-		secret:     quaistudio.Env(envSecret, defSecret),
+		secret:     quaistudio.Env(envSecret, ""),
 		serverCert: quaistudio.Env(envServerCert, defServerCert),
 		serverKey:  quaistudio.Env(envServerKey, defServerKey),
 		k8sUrl:     quaistudio.Env(envK8sUrl, defK8sUrl),
 	}
 }
 
+// connectToK8sService dials the k8s-client service, forwarding the token of
+// the caller a request is made for. Both services must share the secret.
 func connectToK8sService(k8sAddr string, logger logger.Logger) *grpc.ClientConn {
-	conn, err := grpc.Dial(k8sAddr, grpc.WithInsecure())
+	conn, err := grpc.Dial(
+		k8sAddr,
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(auth.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor()),
+	)
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to k8s service: %s", err))
 		os.Exit(1)
@@ -122,24 +135,29 @@ func newService(conn *grpc.ClientConn, logger logger.Logger) models.Service {
 	return svc
 }
 
-func startHTTPServer(svc models.Service, port string, certFile string, keyFile string, logger logger.Logger, errs chan error) {
+func startHTTPServer(svc models.Service, authn auth.Authenticator, port string, certFile string, keyFile string, logger logger.Logger, errs chan error) {
 	p := fmt.Sprintf(":%s", port)
 	if certFile != "" || keyFile != "" {
 		logger.Info(fmt.Sprintf("models service started using https, cert %s key %s, exposed port %s", certFile, keyFile, port))
-		errs <- http.ListenAndServeTLS(p, certFile, keyFile, httpapi.MakeHandler(svc, logger))
+		errs <- http.ListenAndServeTLS(p, certFile, keyFile, httpapi.MakeHandler(svc, authn, logger))
 	} else {
 		logger.Info(fmt.Sprintf("models service started using http, exposed port %s", port))
-		errs <- http.ListenAndServe(p, httpapi.MakeHandler(svc, logger))
+		errs <- http.ListenAndServe(p, httpapi.MakeHandler(svc, authn, logger))
 	}
 }
 
-func startGRPCServer(svc models.Service, port string, certFile string, keyFile string, logger logger.Logger, errs chan error) {
+func startGRPCServer(svc models.Service, authn auth.Authenticator, port string, certFile string, keyFile string, logger logger.Logger, errs chan error) {
 	p := fmt.Sprintf(":%s", port)
 	listener, err := net.Listen("tcp", p)
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to listen on port %s: %s", port, err))
 	}
 
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(auth.UnaryServerInterceptor(authn)),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor(authn)),
+	}
+
 	var server *grpc.Server
 	if certFile != "" || keyFile != "" {
 		creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
@@ -148,10 +166,10 @@ func startGRPCServer(svc models.Service, port string, certFile string, keyFile s
 			os.Exit(1)
 		}
 		logger.Info(fmt.Sprintf("models gRPC service started using https on port %s with cert %s key %s", port, certFile, keyFile))
-		server = grpc.NewServer(grpc.Creds(creds))
+		server = grpc.NewServer(append(opts, grpc.Creds(creds))...)
 	} else {
 		logger.Info(fmt.Sprintf("models gRPC service started using http on port %s", port))
-		server = grpc.NewServer()
+		server = grpc.NewServer(opts...)
 	}
 
 	quai.RegisterModelServiceServer(server, grpcapi.NewServer(svc))
@@ -0,0 +1,49 @@
+// Command token issues bearer tokens accepted by the k8s-client and models
+// services. It signs them with the secret in QS_TOKEN_SECRET, which must be
+// the one the called service is started with, and prints them to stdout:
+//
+//	QS_TOKEN_SECRET=... quaistudio-token -subject alice -groups admins -ttl 8h
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/qeek-dev/quaistudio"
+
+	"github.com/hykuan/k8s-client-example/auth"
+)
+
+const (
+	defTTL    = 24 * time.Hour
+	envSecret = "QS_TOKEN_SECRET"
+)
+
+func main() {
+	subject := flag.String("subject", "", "subject the token identifies, required")
+	groups := flag.String("groups", "", "comma separated groups of the subject")
+	ttl := flag.Duration("ttl", defTTL, "time until the token expires")
+	flag.Parse()
+
+	authn, err := auth.New(quaistudio.Env(envSecret, ""))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create authenticator, set %s: %s\n", envSecret, err)
+		os.Exit(1)
+	}
+
+	id := auth.Identity{Subject: *subject}
+	if *groups != "" {
+		id.Groups = strings.Split(*groups, ",")
+	}
+
+	token, err := authn.Issue(id, *ttl)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to issue token: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(token)
+}
@@ -0,0 +1,125 @@
+// Package errors defines the errors the services report to their clients.
+// Each error is classified by a Code, which the HTTP and gRPC transports
+// translate to their own status codes, so that a failure means the same
+// thing whichever way it is reported.
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Code classifies an error by what the caller can do about it.
+type Code string
+
+const (
+	// Invalid means the request itself is malformed and must be fixed.
+	Invalid Code = "invalid"
+
+	// NotFound means the requested entity doesn't exist.
+	NotFound Code = "not_found"
+
+	// AlreadyExists means an entity of the same name already exists.
+	AlreadyExists Code = "already_exists"
+
+	// QuotaExceeded means the request would exceed a resource quota.
+	QuotaExceeded Code = "quota_exceeded"
+
+	// Forbidden means the caller isn't allowed to make the request.
+	Forbidden Code = "forbidden"
+
+	// Unauthenticated means the caller couldn't be identified.
+	Unauthenticated Code = "unauthenticated"
+
+	// Unavailable means the request may succeed if retried later.
+	Unavailable Code = "unavailable"
+
+	// Internal means the request failed for reasons the caller can't fix.
+	Internal Code = "internal"
+)
+
+const malformedEntity = "malformed entity specification"
+
+// Error is an error classified by Code. Invalid errors may name the fields
+// that caused them.
+type Error struct {
+	Code    Code
+	Message string
+	Fields  []FieldViolation
+}
+
+// FieldViolation names a field of a request and why its value was rejected.
+type FieldViolation struct {
+	Field  string
+	Reason string
+}
+
+// New returns an error of the given code.
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	if len(e.Fields) == 0 {
+		return e.Message
+	}
+
+	var fields []string
+	for _, f := range e.Fields {
+		fields = append(fields, f.Field+" "+f.Reason)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Message, strings.Join(fields, ", "))
+}
+
+// Is reports whether target is an error of the same code and message, so that
+// errors compare equal to the ones they were decoded from.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code && t.Message == e.Message
+}
+
+// FieldError is a malformed entity specification caused by the value of a
+// single field, which it names.
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s %s", malformedEntity, e.Field, e.Reason)
+}
+
+// From returns err as an *Error. Errors that weren't classified are reported
+// as Internal without their message, which may leak implementation details.
+func From(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return &Error{
+			Code:    Invalid,
+			Message: malformedEntity,
+			Fields:  []FieldViolation{{Field: fieldErr.Field, Reason: fieldErr.Reason}},
+		}
+	}
+
+	return New(Internal, "internal server error")
+}
+
+// CodeOf returns the code of err, or an empty code if err is nil.
+func CodeOf(err error) Code {
+	if err == nil {
+		return ""
+	}
+
+	return From(err).Code
+}
@@ -0,0 +1,79 @@
+package errors_test
+
+import (
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hykuan/k8s-client-example/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errNotFound = errors.New(errors.NotFound, "non-existent entity")
+
+func TestFrom(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		code errors.Code
+	}{
+		"classified error":         {errNotFound, errors.NotFound},
+		"wrapped classified error": {fmt.Errorf("get deployment: %w", errNotFound), errors.NotFound},
+		"field error":              {&errors.FieldError{Field: "image", Reason: "is required"}, errors.Invalid},
+		"unclassified error":       {goerrors.New("connection refused"), errors.Internal},
+	}
+
+	for desc, tc := range cases {
+		e := errors.From(tc.err)
+		assert.Equal(t, tc.code, e.Code, fmt.Sprintf("%s: expected %s got %s", desc, tc.code, e.Code))
+	}
+
+	e := errors.From(goerrors.New("connection refused"))
+	assert.NotContains(t, e.Message, "connection refused", "internal error leaked")
+	assert.Nil(t, errors.From(nil), "nil error classified")
+}
+
+func TestStatusRoundTrip(t *testing.T) {
+	cases := map[string]struct {
+		err    error
+		code   codes.Code
+		fields []errors.FieldViolation
+	}{
+		"not found":   {errNotFound, codes.NotFound, nil},
+		"field error": {&errors.FieldError{Field: "image", Reason: "is required"}, codes.InvalidArgument, []errors.FieldViolation{{Field: "image", Reason: "is required"}}},
+		"quota":       {errors.New(errors.QuotaExceeded, "exceeded quota: gpu"), codes.ResourceExhausted, nil},
+		"internal":    {goerrors.New("connection refused"), codes.Internal, nil},
+	}
+
+	for desc, tc := range cases {
+		encoded := errors.ToStatus(tc.err)
+		assert.Equal(t, tc.code, status.Code(encoded), fmt.Sprintf("%s: wrong gRPC code", desc))
+
+		decoded := errors.FromStatus(encoded)
+		e, ok := decoded.(*errors.Error)
+		require.True(t, ok, fmt.Sprintf("%s: expected *errors.Error got %T", desc, decoded))
+		assert.Equal(t, errors.From(tc.err), e, fmt.Sprintf("%s: error changed by round trip", desc))
+	}
+
+	assert.True(t, goerrors.Is(errors.FromStatus(errors.ToStatus(errNotFound)), errNotFound), "decoded error doesn't match sentinel")
+	assert.Nil(t, errors.ToStatus(nil), "nil error encoded")
+	assert.Nil(t, errors.FromStatus(nil), "nil error decoded")
+}
+
+func TestHTTPStatus(t *testing.T) {
+	cases := map[errors.Code]int{
+		errors.Invalid:       http.StatusBadRequest,
+		errors.NotFound:      http.StatusNotFound,
+		errors.AlreadyExists: http.StatusConflict,
+		errors.QuotaExceeded: http.StatusForbidden,
+		errors.Unavailable:   http.StatusServiceUnavailable,
+		errors.Code("bogus"): http.StatusInternalServerError,
+	}
+
+	for code, expected := range cases {
+		assert.Equal(t, expected, code.HTTPStatus(), fmt.Sprintf("%s: wrong HTTP status", code))
+	}
+}
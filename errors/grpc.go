@@ -0,0 +1,85 @@
+package errors
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var grpcCodes = map[Code]codes.Code{
+	Invalid:         codes.InvalidArgument,
+	NotFound:        codes.NotFound,
+	AlreadyExists:   codes.AlreadyExists,
+	QuotaExceeded:   codes.ResourceExhausted,
+	Forbidden:       codes.PermissionDenied,
+	Unauthenticated: codes.Unauthenticated,
+	Unavailable:     codes.Unavailable,
+	Internal:        codes.Internal,
+}
+
+var fromGRPCCodes = map[codes.Code]Code{
+	codes.InvalidArgument:   Invalid,
+	codes.OutOfRange:        Invalid,
+	codes.NotFound:          NotFound,
+	codes.AlreadyExists:     AlreadyExists,
+	codes.ResourceExhausted: QuotaExceeded,
+	codes.PermissionDenied:  Forbidden,
+	codes.Unauthenticated:   Unauthenticated,
+	codes.Unavailable:       Unavailable,
+	codes.DeadlineExceeded:  Unavailable,
+	codes.Canceled:          Unavailable,
+}
+
+// ToStatus encodes err as a gRPC status error. Its fields are attached as
+// BadRequest details.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	e := From(err)
+	st := status.New(grpcCodes[e.Code], e.Message)
+	if len(e.Fields) == 0 {
+		return st.Err()
+	}
+
+	details := &errdetails.BadRequest{}
+	for _, f := range e.Fields {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Reason,
+		})
+	}
+
+	withDetails, detailsErr := st.WithDetails(details)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// FromStatus decodes the *Error encoded by ToStatus from a gRPC status error.
+// Errors without a gRPC status are returned as is.
+func FromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return err
+	}
+
+	code, ok := fromGRPCCodes[st.Code()]
+	if !ok {
+		code = Internal
+	}
+
+	e := &Error{Code: code, Message: st.Message()}
+	for _, detail := range st.Details() {
+		if details, ok := detail.(*errdetails.BadRequest); ok {
+			for _, f := range details.FieldViolations {
+				e.Fields = append(e.Fields, FieldViolation{Field: f.Field, Reason: f.Description})
+			}
+		}
+	}
+
+	return e
+}
@@ -0,0 +1,23 @@
+package errors
+
+import "net/http"
+
+var httpStatuses = map[Code]int{
+	Invalid:         http.StatusBadRequest,
+	NotFound:        http.StatusNotFound,
+	AlreadyExists:   http.StatusConflict,
+	QuotaExceeded:   http.StatusForbidden,
+	Forbidden:       http.StatusForbidden,
+	Unauthenticated: http.StatusUnauthorized,
+	Unavailable:     http.StatusServiceUnavailable,
+	Internal:        http.StatusInternalServerError,
+}
+
+// HTTPStatus returns the HTTP status code that reports errors of code c.
+func (c Code) HTTPStatus() int {
+	if status, ok := httpStatuses[c]; ok {
+		return status
+	}
+
+	return http.StatusInternalServerError
+}
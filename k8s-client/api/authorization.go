@@ -0,0 +1,382 @@
+package api
+
+import (
+	"context"
+
+	"github.com/hykuan/k8s-client-example/auth"
+	"github.com/hykuan/k8s-client-example/k8s-client"
+)
+
+var _ k8s_client.Service = (*authorizationMiddleware)(nil)
+
+// clusterScoped lists the kinds whose resources don't belong to a namespace.
+var clusterScoped = map[string]bool{
+	KindPersistentVolumes: true,
+	KindNodes:             true,
+}
+
+type authorizationMiddleware struct {
+	policy    Policy
+	namespace string
+	svc       k8s_client.Service
+}
+
+// AuthorizationMiddleware denies requests that policy doesn't allow the
+// authenticated caller to make. Requests without a namespace are authorized
+// against namespace, the default one of the service.
+func AuthorizationMiddleware(svc k8s_client.Service, policy Policy, namespace string) k8s_client.Service {
+	return &authorizationMiddleware{
+		policy:    policy,
+		namespace: namespace,
+		svc:       svc,
+	}
+}
+
+func (am *authorizationMiddleware) CreatePV(ctx context.Context, pv k8s_client.PersistentVolume, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	if err := am.authorizeCreate(ctx, KindPersistentVolumes, "", opts); err != nil {
+		return k8s_client.CreateResult{}, err
+	}
+
+	return am.svc.CreatePV(ctx, pv, opts)
+}
+
+func (am *authorizationMiddleware) CreateNFSPV(ctx context.Context, nfsPV k8s_client.NFSPersistentVolume, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	if err := am.authorizeCreate(ctx, KindPersistentVolumes, "", opts); err != nil {
+		return k8s_client.CreateResult{}, err
+	}
+
+	return am.svc.CreateNFSPV(ctx, nfsPV, opts)
+}
+
+func (am *authorizationMiddleware) CreatePVC(ctx context.Context, pvc k8s_client.PersistentVolumeClaim, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	if err := am.authorizeCreate(ctx, KindPersistentVolumeClaims, pvc.Namespace, opts); err != nil {
+		return k8s_client.CreateResult{}, err
+	}
+
+	return am.svc.CreatePVC(ctx, pvc, opts)
+}
+
+func (am *authorizationMiddleware) CreateDeployment(ctx context.Context, deployment k8s_client.Deployment, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	if err := am.authorizeCreate(ctx, KindDeployments, deployment.Namespace, opts); err != nil {
+		return k8s_client.CreateResult{}, err
+	}
+
+	return am.svc.CreateDeployment(ctx, deployment, opts)
+}
+
+func (am *authorizationMiddleware) GetPV(ctx context.Context, name string) (k8s_client.PersistentVolumeStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindPersistentVolumes, ""); err != nil {
+		return k8s_client.PersistentVolumeStatus{}, err
+	}
+
+	return am.svc.GetPV(ctx, name)
+}
+
+func (am *authorizationMiddleware) ListPVs(ctx context.Context) ([]k8s_client.PersistentVolumeStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindPersistentVolumes, ""); err != nil {
+		return nil, err
+	}
+
+	return am.svc.ListPVs(ctx)
+}
+
+func (am *authorizationMiddleware) GetPVC(ctx context.Context, namespace, name string) (k8s_client.PersistentVolumeClaimStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindPersistentVolumeClaims, namespace); err != nil {
+		return k8s_client.PersistentVolumeClaimStatus{}, err
+	}
+
+	return am.svc.GetPVC(ctx, namespace, name)
+}
+
+func (am *authorizationMiddleware) ListPVCs(ctx context.Context, namespace string) ([]k8s_client.PersistentVolumeClaimStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindPersistentVolumeClaims, namespace); err != nil {
+		return nil, err
+	}
+
+	return am.svc.ListPVCs(ctx, namespace)
+}
+
+func (am *authorizationMiddleware) GetDeployment(ctx context.Context, namespace, name string) (k8s_client.DeploymentStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindDeployments, namespace); err != nil {
+		return k8s_client.DeploymentStatus{}, err
+	}
+
+	return am.svc.GetDeployment(ctx, namespace, name)
+}
+
+func (am *authorizationMiddleware) ListDeployments(ctx context.Context, namespace string) ([]k8s_client.DeploymentStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindDeployments, namespace); err != nil {
+		return nil, err
+	}
+
+	return am.svc.ListDeployments(ctx, namespace)
+}
+
+func (am *authorizationMiddleware) DeletePV(ctx context.Context, name string, opts k8s_client.DeleteOptions) error {
+	if err := am.authorize(ctx, VerbDelete, KindPersistentVolumes, ""); err != nil {
+		return err
+	}
+
+	return am.svc.DeletePV(ctx, name, opts)
+}
+
+func (am *authorizationMiddleware) DeletePVC(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	if err := am.authorize(ctx, VerbDelete, KindPersistentVolumeClaims, namespace); err != nil {
+		return err
+	}
+
+	return am.svc.DeletePVC(ctx, namespace, name, opts)
+}
+
+func (am *authorizationMiddleware) DeleteDeployment(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	if err := am.authorize(ctx, VerbDelete, KindDeployments, namespace); err != nil {
+		return err
+	}
+
+	return am.svc.DeleteDeployment(ctx, namespace, name, opts)
+}
+
+func (am *authorizationMiddleware) UpdateDeployment(ctx context.Context, deployment k8s_client.Deployment) (string, error) {
+	if err := am.authorize(ctx, VerbUpdate, KindDeployments, deployment.Namespace); err != nil {
+		return "", err
+	}
+
+	return am.svc.UpdateDeployment(ctx, deployment)
+}
+
+func (am *authorizationMiddleware) ScaleDeployment(ctx context.Context, namespace, name string, replicas int32) error {
+	if err := am.authorize(ctx, VerbUpdate, KindDeployments, namespace); err != nil {
+		return err
+	}
+
+	return am.svc.ScaleDeployment(ctx, namespace, name, replicas)
+}
+
+func (am *authorizationMiddleware) CreateJob(ctx context.Context, job k8s_client.Job, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	if err := am.authorizeCreate(ctx, KindJobs, job.Namespace, opts); err != nil {
+		return k8s_client.CreateResult{}, err
+	}
+
+	return am.svc.CreateJob(ctx, job, opts)
+}
+
+func (am *authorizationMiddleware) WatchDeployment(ctx context.Context, namespace, name string) (<-chan k8s_client.WorkloadEvent, error) {
+	if err := am.authorize(ctx, VerbRead, KindDeployments, namespace); err != nil {
+		return nil, err
+	}
+
+	return am.svc.WatchDeployment(ctx, namespace, name)
+}
+
+func (am *authorizationMiddleware) WatchJob(ctx context.Context, namespace, name string) (<-chan k8s_client.WorkloadEvent, error) {
+	if err := am.authorize(ctx, VerbRead, KindJobs, namespace); err != nil {
+		return nil, err
+	}
+
+	return am.svc.WatchJob(ctx, namespace, name)
+}
+
+func (am *authorizationMiddleware) StreamLogs(ctx context.Context, namespace, name string, opts k8s_client.LogOptions) (<-chan k8s_client.LogLine, error) {
+	if err := am.authorize(ctx, VerbRead, KindPods, namespace); err != nil {
+		return nil, err
+	}
+
+	return am.svc.StreamLogs(ctx, namespace, name, opts)
+}
+
+func (am *authorizationMiddleware) GetNodeMetrics(ctx context.Context) ([]k8s_client.NodeMetrics, error) {
+	if err := am.authorize(ctx, VerbRead, KindNodes, ""); err != nil {
+		return nil, err
+	}
+
+	return am.svc.GetNodeMetrics(ctx)
+}
+
+func (am *authorizationMiddleware) GetPodMetrics(ctx context.Context, namespace, name string) ([]k8s_client.PodMetrics, error) {
+	if err := am.authorize(ctx, VerbRead, KindPods, namespace); err != nil {
+		return nil, err
+	}
+
+	return am.svc.GetPodMetrics(ctx, namespace, name)
+}
+
+func (am *authorizationMiddleware) ClusterCapacity(ctx context.Context) ([]k8s_client.NodeCapacity, error) {
+	if err := am.authorize(ctx, VerbRead, KindNodes, ""); err != nil {
+		return nil, err
+	}
+
+	return am.svc.ClusterCapacity(ctx)
+}
+
+func (am *authorizationMiddleware) CanSchedule(ctx context.Context, deployment k8s_client.Deployment) (k8s_client.ScheduleResult, error) {
+	if err := am.authorize(ctx, VerbRead, KindNodes, ""); err != nil {
+		return k8s_client.ScheduleResult{}, err
+	}
+
+	return am.svc.CanSchedule(ctx, deployment)
+}
+
+func (am *authorizationMiddleware) CreateRegistryCredential(ctx context.Context, cred k8s_client.RegistryCredential, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	if err := am.authorizeCreate(ctx, KindSecrets, cred.Namespace, opts); err != nil {
+		return k8s_client.CreateResult{}, err
+	}
+
+	return am.svc.CreateRegistryCredential(ctx, cred, opts)
+}
+
+func (am *authorizationMiddleware) ListRegistryCredentials(ctx context.Context, namespace string) ([]k8s_client.RegistryCredentialStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindSecrets, namespace); err != nil {
+		return nil, err
+	}
+
+	return am.svc.ListRegistryCredentials(ctx, namespace)
+}
+
+func (am *authorizationMiddleware) DeleteRegistryCredential(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	if err := am.authorize(ctx, VerbDelete, KindSecrets, namespace); err != nil {
+		return err
+	}
+
+	return am.svc.DeleteRegistryCredential(ctx, namespace, name, opts)
+}
+
+func (am *authorizationMiddleware) ExposeDeployment(ctx context.Context, exposure k8s_client.Exposure) (string, error) {
+	if err := am.authorize(ctx, VerbCreate, KindServices, exposure.Namespace); err != nil {
+		return "", err
+	}
+
+	if exposure.Ingress != nil {
+		if err := am.authorize(ctx, VerbCreate, KindIngresses, exposure.Namespace); err != nil {
+			return "", err
+		}
+	}
+
+	return am.svc.ExposeDeployment(ctx, exposure)
+}
+
+func (am *authorizationMiddleware) CreateConfigMap(ctx context.Context, cm k8s_client.ConfigMap, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	if err := am.authorizeCreate(ctx, KindConfigMaps, cm.Namespace, opts); err != nil {
+		return k8s_client.CreateResult{}, err
+	}
+
+	return am.svc.CreateConfigMap(ctx, cm, opts)
+}
+
+func (am *authorizationMiddleware) GetConfigMap(ctx context.Context, namespace, name string) (k8s_client.ConfigMap, error) {
+	if err := am.authorize(ctx, VerbRead, KindConfigMaps, namespace); err != nil {
+		return k8s_client.ConfigMap{}, err
+	}
+
+	return am.svc.GetConfigMap(ctx, namespace, name)
+}
+
+func (am *authorizationMiddleware) UpdateConfigMap(ctx context.Context, cm k8s_client.ConfigMap) (string, error) {
+	if err := am.authorize(ctx, VerbUpdate, KindConfigMaps, cm.Namespace); err != nil {
+		return "", err
+	}
+
+	return am.svc.UpdateConfigMap(ctx, cm)
+}
+
+func (am *authorizationMiddleware) DeleteConfigMap(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	if err := am.authorize(ctx, VerbDelete, KindConfigMaps, namespace); err != nil {
+		return err
+	}
+
+	return am.svc.DeleteConfigMap(ctx, namespace, name, opts)
+}
+
+func (am *authorizationMiddleware) CreateSecret(ctx context.Context, secret k8s_client.Secret, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	if err := am.authorizeCreate(ctx, KindSecrets, secret.Namespace, opts); err != nil {
+		return k8s_client.CreateResult{}, err
+	}
+
+	return am.svc.CreateSecret(ctx, secret, opts)
+}
+
+func (am *authorizationMiddleware) GetSecret(ctx context.Context, namespace, name string) (k8s_client.SecretStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindSecrets, namespace); err != nil {
+		return k8s_client.SecretStatus{}, err
+	}
+
+	return am.svc.GetSecret(ctx, namespace, name)
+}
+
+func (am *authorizationMiddleware) UpdateSecret(ctx context.Context, secret k8s_client.Secret) (string, error) {
+	if err := am.authorize(ctx, VerbUpdate, KindSecrets, secret.Namespace); err != nil {
+		return "", err
+	}
+
+	return am.svc.UpdateSecret(ctx, secret)
+}
+
+func (am *authorizationMiddleware) DeleteSecret(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	if err := am.authorize(ctx, VerbDelete, KindSecrets, namespace); err != nil {
+		return err
+	}
+
+	return am.svc.DeleteSecret(ctx, namespace, name, opts)
+}
+
+func (am *authorizationMiddleware) CreateProject(ctx context.Context, project k8s_client.Project) (string, error) {
+	if err := am.authorize(ctx, VerbCreate, KindProjects, project.Name); err != nil {
+		return "", err
+	}
+
+	return am.svc.CreateProject(ctx, project)
+}
+
+func (am *authorizationMiddleware) GetProject(ctx context.Context, name string) (k8s_client.ProjectStatus, error) {
+	if err := am.authorize(ctx, VerbRead, KindProjects, name); err != nil {
+		return k8s_client.ProjectStatus{}, err
+	}
+
+	return am.svc.GetProject(ctx, name)
+}
+
+func (am *authorizationMiddleware) UpdateProject(ctx context.Context, project k8s_client.Project) (string, error) {
+	if err := am.authorize(ctx, VerbUpdate, KindProjects, project.Name); err != nil {
+		return "", err
+	}
+
+	return am.svc.UpdateProject(ctx, project)
+}
+
+func (am *authorizationMiddleware) DeleteProject(ctx context.Context, name string, opts k8s_client.DeleteOptions) error {
+	if err := am.authorize(ctx, VerbDelete, KindProjects, name); err != nil {
+		return err
+	}
+
+	return am.svc.DeleteProject(ctx, name, opts)
+}
+
+// authorizeCreate authorizes a create which, with opts.Apply, may update the
+// existing object instead and so also needs the update verb.
+func (am *authorizationMiddleware) authorizeCreate(ctx context.Context, kind, namespace string, opts k8s_client.CreateOptions) error {
+	if err := am.authorize(ctx, VerbCreate, kind, namespace); err != nil {
+		return err
+	}
+
+	if opts.Apply {
+		return am.authorize(ctx, VerbUpdate, kind, namespace)
+	}
+
+	return nil
+}
+
+func (am *authorizationMiddleware) authorize(ctx context.Context, verb, kind, namespace string) error {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return k8s_client.ErrUnauthorizedAccess
+	}
+
+	if namespace == "" && !clusterScoped[kind] {
+		namespace = am.namespace
+	}
+
+	if !am.policy.Allows(id, verb, kind, namespace) {
+		return k8s_client.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
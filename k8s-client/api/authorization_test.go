@@ -0,0 +1,130 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hykuan/k8s-client-example/auth"
+	"github.com/hykuan/k8s-client-example/k8s-client"
+	"github.com/hykuan/k8s-client-example/k8s-client/api"
+	"github.com/hykuan/k8s-client-example/k8s-client/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	namespace = "training"
+	image     = "tensorflow/tensorflow:latest-gpu"
+)
+
+const policy = `
+rules:
+  - groups: ["admins"]
+    verbs: ["*"]
+    kinds: ["*"]
+    namespaces: ["*"]
+  - subjects: ["alice"]
+    verbs: ["create", "read"]
+    kinds: ["deployments", "services"]
+    namespaces: ["training"]
+`
+
+func TestAuthorizationMiddleware(t *testing.T) {
+	dir, err := ioutil.TempDir("", "policy")
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating directory: %s", err))
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "policy.yaml")
+	require.Nil(t, ioutil.WriteFile(path, []byte(policy), 0600))
+
+	p, err := api.LoadPolicy(path)
+	require.Nil(t, err, fmt.Sprintf("unexpected error loading policy: %s", err))
+
+	admin := auth.Identity{Subject: "bob", Groups: []string{"admins"}}
+	alice := auth.Identity{Subject: "alice"}
+
+	cases := map[string]struct {
+		id  *auth.Identity
+		op  func(context.Context, k8s_client.Service) error
+		err error
+	}{
+		"admin creates pv": {&admin, createPV, nil},
+		"alice creates pv": {&alice, createPV, k8s_client.ErrUnauthorizedAccess},
+		"alice creates deployment in default namespace": {&alice, createDeployment(""), nil},
+		"alice creates deployment in other namespace":   {&alice, createDeployment("other"), k8s_client.ErrUnauthorizedAccess},
+		"alice deletes deployment":                      {&alice, deleteDeployment, k8s_client.ErrUnauthorizedAccess},
+		"anonymous creates deployment":                  {nil, createDeployment(namespace), k8s_client.ErrUnauthorizedAccess},
+		"alice applies over existing deployment":        {&alice, applyDeployment, k8s_client.ErrUnauthorizedAccess},
+		"admin applies over existing deployment":        {&admin, applyDeployment, nil},
+		"alice exposes deployment":                      {&alice, exposeDeployment(nil), nil},
+		"alice exposes deployment through ingress":      {&alice, exposeDeployment(&k8s_client.IngressRule{Host: "mnist.example.com"}), k8s_client.ErrUnauthorizedAccess},
+		"admin exposes deployment through ingress":      {&admin, exposeDeployment(&k8s_client.IngressRule{Host: "mnist.example.com"}), nil},
+	}
+
+	for desc, tc := range cases {
+		svc := api.AuthorizationMiddleware(mocks.NewHarness(namespace).Service, p, namespace)
+
+		ctx := context.Background()
+		if tc.id != nil {
+			ctx = auth.WithIdentity(ctx, *tc.id)
+		}
+
+		err := tc.op(ctx, svc)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+	}
+}
+
+func TestLoadPolicyRejectsUnknownFields(t *testing.T) {
+	dir, err := ioutil.TempDir("", "policy")
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating directory: %s", err))
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "policy.yaml")
+	require.Nil(t, ioutil.WriteFile(path, []byte("rules:\n  - subject: [alice]\n"), 0600))
+
+	_, err = api.LoadPolicy(path)
+	assert.NotNil(t, err, "misspelled rule field accepted")
+}
+
+func createPV(ctx context.Context, svc k8s_client.Service) error {
+	_, err := svc.CreateNFSPV(ctx, k8s_client.NFSPersistentVolume{Name: "nfs", Storage: "10Gi", Server: "10.0.0.1", Path: "/exports"}, k8s_client.CreateOptions{})
+	return err
+}
+
+func createDeployment(ns string) func(context.Context, k8s_client.Service) error {
+	return func(ctx context.Context, svc k8s_client.Service) error {
+		_, err := svc.CreateDeployment(ctx, k8s_client.Deployment{Name: "mnist", Namespace: ns, Image: image}, k8s_client.CreateOptions{})
+		return err
+	}
+}
+
+func applyDeployment(ctx context.Context, svc k8s_client.Service) error {
+	deployment := k8s_client.Deployment{Name: "mnist", Image: image}
+	if _, err := svc.CreateDeployment(ctx, deployment, k8s_client.CreateOptions{}); err != nil {
+		return err
+	}
+
+	deployment.Image = "tensorflow/tensorflow:2.1.0-gpu"
+	_, err := svc.CreateDeployment(ctx, deployment, k8s_client.CreateOptions{Apply: true})
+	return err
+}
+
+func exposeDeployment(ingress *k8s_client.IngressRule) func(context.Context, k8s_client.Service) error {
+	return func(ctx context.Context, svc k8s_client.Service) error {
+		ports := []*k8s_client.ContainerPort{{ContainerPort: 8080}}
+		if _, err := svc.CreateDeployment(ctx, k8s_client.Deployment{Name: "mnist", Image: image, Ports: ports}, k8s_client.CreateOptions{}); err != nil {
+			return err
+		}
+
+		_, err := svc.ExposeDeployment(ctx, k8s_client.Exposure{Name: "mnist", Ingress: ingress})
+		return err
+	}
+}
+
+func deleteDeployment(ctx context.Context, svc k8s_client.Service) error {
+	return svc.DeleteDeployment(ctx, namespace, "mnist", k8s_client.DeleteOptions{})
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/go-kit/kit/endpoint"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/errors"
+	"github.com/hykuan/k8s-client-example/k8s-client"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
@@ -11,9 +13,43 @@ import (
 var _ quai.K8SClientServiceClient = (*grpcClient)(nil)
 
 type grpcClient struct {
+	streams                     quai.K8SClientServiceClient
 	createNFSPersistentVolume   endpoint.Endpoint
+	createPersistentVolume      endpoint.Endpoint
 	createPersistentVolumeClaim endpoint.Endpoint
 	createDeployment            endpoint.Endpoint
+	getPersistentVolume         endpoint.Endpoint
+	listPersistentVolumes       endpoint.Endpoint
+	getPersistentVolumeClaim    endpoint.Endpoint
+	listPersistentVolumeClaims  endpoint.Endpoint
+	getDeployment               endpoint.Endpoint
+	listDeployments             endpoint.Endpoint
+	deletePersistentVolume      endpoint.Endpoint
+	deletePersistentVolumeClaim endpoint.Endpoint
+	deleteDeployment            endpoint.Endpoint
+	updateDeployment            endpoint.Endpoint
+	scaleDeployment             endpoint.Endpoint
+	createJob                   endpoint.Endpoint
+	getNodeMetrics              endpoint.Endpoint
+	getPodMetrics               endpoint.Endpoint
+	getClusterCapacity          endpoint.Endpoint
+	canSchedule                 endpoint.Endpoint
+	createRegistryCredential    endpoint.Endpoint
+	listRegistryCredentials     endpoint.Endpoint
+	deleteRegistryCredential    endpoint.Endpoint
+	exposeDeployment            endpoint.Endpoint
+	createConfigMap             endpoint.Endpoint
+	getConfigMap                endpoint.Endpoint
+	updateConfigMap             endpoint.Endpoint
+	deleteConfigMap             endpoint.Endpoint
+	createSecret                endpoint.Endpoint
+	getSecret                   endpoint.Endpoint
+	updateSecret                endpoint.Endpoint
+	deleteSecret                endpoint.Endpoint
+	createProject               endpoint.Endpoint
+	getProject                  endpoint.Endpoint
+	updateProject               endpoint.Endpoint
+	deleteProject               endpoint.Endpoint
 }
 
 // NewClient returns new gRPC client instance.
@@ -21,104 +57,852 @@ func NewClient(conn *grpc.ClientConn) quai.K8SClientServiceClient {
 	svcName := "quai.K8sClientService"
 
 	return &grpcClient{
-		createNFSPersistentVolume: kitgrpc.NewClient(
+		streams: quai.NewK8SClientServiceClient(conn),
+		createNFSPersistentVolume: decodeErrors(kitgrpc.NewClient(
 			conn,
 			svcName,
 			"CreateNFSPersistentVolume",
 			encodeCreateNFSPVRequest,
 			decodeCreateNFSPVResponse,
 			quai.PersistentVolumeName{},
-		).Endpoint(),
-		createPersistentVolumeClaim: kitgrpc.NewClient(
+		).Endpoint()),
+		createPersistentVolume: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CreatePersistentVolume",
+			encodeCreatePVRequest,
+			decodeCreateNFSPVResponse,
+			quai.PersistentVolumeName{},
+		).Endpoint()),
+		createPersistentVolumeClaim: decodeErrors(kitgrpc.NewClient(
 			conn,
 			svcName,
 			"CreatePersistentVolumeClaim",
 			encodeCreatePVCRequest,
 			decodeCreatePVCResponse,
 			quai.PersistentVolumeClaimName{},
-		).Endpoint(),
-		createDeployment: kitgrpc.NewClient(
+		).Endpoint()),
+		createDeployment: decodeErrors(kitgrpc.NewClient(
 			conn,
 			svcName,
 			"CreateDeployment",
 			encodeCreateDeploymentRequest,
 			decodeCreateDeploymentResponse,
 			quai.DeploymentName{},
-		).Endpoint(),
+		).Endpoint()),
+		getPersistentVolume: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetPersistentVolume",
+			encodeGetPVRequest,
+			decodeGetPVResponse,
+			quai.PersistentVolume{},
+		).Endpoint()),
+		listPersistentVolumes: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"ListPersistentVolumes",
+			encodeListPVsRequest,
+			decodeListPVsResponse,
+			quai.PersistentVolumeList{},
+		).Endpoint()),
+		getPersistentVolumeClaim: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetPersistentVolumeClaim",
+			encodeGetPVCRequest,
+			decodeGetPVCResponse,
+			quai.PersistentVolumeClaim{},
+		).Endpoint()),
+		listPersistentVolumeClaims: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"ListPersistentVolumeClaims",
+			encodeListPVCsRequest,
+			decodeListPVCsResponse,
+			quai.PersistentVolumeClaimList{},
+		).Endpoint()),
+		getDeployment: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetDeployment",
+			encodeGetDeploymentRequest,
+			decodeGetDeploymentResponse,
+			quai.Deployment{},
+		).Endpoint()),
+		listDeployments: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"ListDeployments",
+			encodeListDeploymentsRequest,
+			decodeListDeploymentsResponse,
+			quai.DeploymentList{},
+		).Endpoint()),
+		deletePersistentVolume: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"DeletePersistentVolume",
+			encodeDeletePVRequest,
+			decodeDeletePVResponse,
+			quai.PersistentVolumeName{},
+		).Endpoint()),
+		deletePersistentVolumeClaim: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"DeletePersistentVolumeClaim",
+			encodeDeletePVCRequest,
+			decodeDeletePVCResponse,
+			quai.PersistentVolumeClaimName{},
+		).Endpoint()),
+		deleteDeployment: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"DeleteDeployment",
+			encodeDeleteDeploymentRequest,
+			decodeDeleteDeploymentResponse,
+			quai.DeploymentName{},
+		).Endpoint()),
+		updateDeployment: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"UpdateDeployment",
+			encodeUpdateDeploymentRequest,
+			decodeCreateDeploymentResponse,
+			quai.DeploymentName{},
+		).Endpoint()),
+		scaleDeployment: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"ScaleDeployment",
+			encodeScaleDeploymentRequest,
+			decodeCreateDeploymentResponse,
+			quai.DeploymentName{},
+		).Endpoint()),
+		createJob: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CreateJob",
+			encodeCreateJobRequest,
+			decodeCreateJobResponse,
+			quai.JobName{},
+		).Endpoint()),
+		getNodeMetrics: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetNodeMetrics",
+			encodeGetNodeMetricsRequest,
+			decodeGetNodeMetricsResponse,
+			quai.NodeMetricsList{},
+		).Endpoint()),
+		getPodMetrics: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetPodMetrics",
+			encodeGetPodMetricsRequest,
+			decodeGetPodMetricsResponse,
+			quai.PodMetricsList{},
+		).Endpoint()),
+		getClusterCapacity: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetClusterCapacity",
+			encodeGetClusterCapacityRequest,
+			decodeGetClusterCapacityResponse,
+			quai.ClusterCapacity{},
+		).Endpoint()),
+		canSchedule: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CanSchedule",
+			encodeCreateDeploymentRequest,
+			decodeCanScheduleResponse,
+			quai.ScheduleResult{},
+		).Endpoint()),
+		createRegistryCredential: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CreateRegistryCredential",
+			encodeCreateRegistryCredentialRequest,
+			decodeCreateRegistryCredentialResponse,
+			quai.RegistryCredentialName{},
+		).Endpoint()),
+		listRegistryCredentials: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"ListRegistryCredentials",
+			encodeListRegistryCredentialsRequest,
+			decodeListRegistryCredentialsResponse,
+			quai.RegistryCredentialList{},
+		).Endpoint()),
+		deleteRegistryCredential: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"DeleteRegistryCredential",
+			encodeDeleteRegistryCredentialRequest,
+			decodeDeleteRegistryCredentialResponse,
+			quai.RegistryCredentialName{},
+		).Endpoint()),
+		exposeDeployment: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"ExposeDeployment",
+			encodeExposeRequest,
+			decodeExposeResponse,
+			quai.ServiceName{},
+		).Endpoint()),
+		createConfigMap: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CreateConfigMap",
+			encodeCreateConfigMapRequest,
+			decodeCreateConfigMapResponse,
+			quai.ConfigMapName{},
+		).Endpoint()),
+		getConfigMap: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetConfigMap",
+			encodeGetConfigMapRequest,
+			decodeGetConfigMapResponse,
+			quai.ConfigMap{},
+		).Endpoint()),
+		updateConfigMap: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"UpdateConfigMap",
+			encodeCreateConfigMapRequest,
+			decodeCreateConfigMapResponse,
+			quai.ConfigMapName{},
+		).Endpoint()),
+		deleteConfigMap: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"DeleteConfigMap",
+			encodeDeleteConfigMapRequest,
+			decodeDeleteConfigMapResponse,
+			quai.ConfigMapName{},
+		).Endpoint()),
+		createSecret: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CreateSecret",
+			encodeCreateSecretRequest,
+			decodeCreateSecretResponse,
+			quai.SecretName{},
+		).Endpoint()),
+		getSecret: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetSecret",
+			encodeGetSecretRequest,
+			decodeGetSecretResponse,
+			quai.Secret{},
+		).Endpoint()),
+		updateSecret: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"UpdateSecret",
+			encodeCreateSecretRequest,
+			decodeCreateSecretResponse,
+			quai.SecretName{},
+		).Endpoint()),
+		deleteSecret: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"DeleteSecret",
+			encodeDeleteSecretRequest,
+			decodeDeleteSecretResponse,
+			quai.SecretName{},
+		).Endpoint()),
+		createProject: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CreateProject",
+			encodeCreateProjectRequest,
+			decodeCreateProjectResponse,
+			quai.ProjectName{},
+		).Endpoint()),
+		getProject: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetProject",
+			encodeGetProjectRequest,
+			decodeGetProjectResponse,
+			quai.Project{},
+		).Endpoint()),
+		updateProject: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"UpdateProject",
+			encodeCreateProjectRequest,
+			decodeCreateProjectResponse,
+			quai.ProjectName{},
+		).Endpoint()),
+		deleteProject: decodeErrors(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"DeleteProject",
+			encodeDeleteProjectRequest,
+			decodeDeleteProjectResponse,
+			quai.ProjectName{},
+		).Endpoint()),
+	}
+}
+
+// decodeErrors turns the gRPC status errors returned by e back into the
+// errors the server encoded, so that callers can tell them apart by code.
+func decodeErrors(e endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		res, err := e(ctx, request)
+		return res, errors.FromStatus(err)
+	}
+}
+
+func (client *grpcClient) CreateNFSPersistentVolume(ctx context.Context, req *quai.NFSPersistentVolumeReq, _ ...grpc.CallOption) (*quai.PersistentVolumeName, error) {
+	pvReq := createNFSPVReq{
+		Name: req.Name, Storage: req.Storage, Server: req.Server, Path: req.Path,
+		AccessModes: req.AccessModes, StorageClassName: req.StorageClassName, Labels: req.Labels,
+		Options: fromCreateOptionsMessage(req.Options),
+	}
+
+	res, err := client.createNFSPersistentVolume(ctx, pvReq)
+	if err != nil {
+		return nil, err
+	}
+
+	pvRes := res.(createPVRes)
+	return &quai.PersistentVolumeName{Value: pvRes.name, Manifest: pvRes.manifest}, pvRes.err
+}
+
+func (client *grpcClient) CreatePersistentVolume(ctx context.Context, req *quai.PersistentVolumeReq, _ ...grpc.CallOption) (*quai.PersistentVolumeName, error) {
+	pvReq, err := decodeCreatePVRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.createPersistentVolume(ctx, pvReq)
+	if err != nil {
+		return nil, err
+	}
+
+	pvRes := res.(createPVRes)
+	return &quai.PersistentVolumeName{Value: pvRes.name, Manifest: pvRes.manifest}, pvRes.err
+}
+
+func (client *grpcClient) CreatePersistentVolumeClaim(ctx context.Context, req *quai.PersistentVolumeClaimReq, _ ...grpc.CallOption) (*quai.PersistentVolumeClaimName, error) {
+	pvcReq, err := decodeCreatePVCRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.createPersistentVolumeClaim(ctx, pvcReq)
+	if err != nil {
+		return nil, err
+	}
+
+	pvcRes := res.(createPVCRes)
+	return &quai.PersistentVolumeClaimName{Value: pvcRes.name, Manifest: pvcRes.manifest}, pvcRes.err
+}
+
+func (client *grpcClient) CreateDeployment(ctx context.Context, req *quai.DeploymentReq, _ ...grpc.CallOption) (*quai.DeploymentName, error) {
+	deploymentReq, err := decodeCreateDeploymentRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.createDeployment(ctx, deploymentReq)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentRes := res.(createDeploymentRes)
+	return &quai.DeploymentName{Value: deploymentRes.name, Manifest: deploymentRes.manifest}, deploymentRes.err
+}
+
+func (client *grpcClient) GetPersistentVolume(ctx context.Context, req *quai.GetPersistentVolumeReq, _ ...grpc.CallOption) (*quai.PersistentVolume, error) {
+	res, err := client.getPersistentVolume(ctx, getPVReq{Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	pvRes := res.(getPVRes)
+	return toPVMessage(pvRes.pv), pvRes.err
+}
+
+func (client *grpcClient) ListPersistentVolumes(ctx context.Context, req *quai.ListPersistentVolumesReq, _ ...grpc.CallOption) (*quai.PersistentVolumeList, error) {
+	res, err := client.listPersistentVolumes(ctx, listPVsReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	pvsRes := res.(listPVsRes)
+	list := &quai.PersistentVolumeList{}
+	for _, pv := range pvsRes.pvs {
+		list.Items = append(list.Items, toPVMessage(pv))
+	}
+	return list, pvsRes.err
+}
+
+func (client *grpcClient) GetPersistentVolumeClaim(ctx context.Context, req *quai.GetPersistentVolumeClaimReq, _ ...grpc.CallOption) (*quai.PersistentVolumeClaim, error) {
+	res, err := client.getPersistentVolumeClaim(ctx, getPVCReq{Name: req.Name, Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	pvcRes := res.(getPVCRes)
+	return toPVCMessage(pvcRes.pvc), pvcRes.err
+}
+
+func (client *grpcClient) ListPersistentVolumeClaims(ctx context.Context, req *quai.ListPersistentVolumeClaimsReq, _ ...grpc.CallOption) (*quai.PersistentVolumeClaimList, error) {
+	res, err := client.listPersistentVolumeClaims(ctx, listPVCsReq{Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	pvcsRes := res.(listPVCsRes)
+	list := &quai.PersistentVolumeClaimList{}
+	for _, pvc := range pvcsRes.pvcs {
+		list.Items = append(list.Items, toPVCMessage(pvc))
+	}
+	return list, pvcsRes.err
+}
+
+func (client *grpcClient) GetDeployment(ctx context.Context, req *quai.GetDeploymentReq, _ ...grpc.CallOption) (*quai.Deployment, error) {
+	res, err := client.getDeployment(ctx, getDeploymentReq{Name: req.Name, Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentRes := res.(getDeploymentRes)
+	return toDeploymentMessage(deploymentRes.deployment), deploymentRes.err
+}
+
+func (client *grpcClient) ListDeployments(ctx context.Context, req *quai.ListDeploymentsReq, _ ...grpc.CallOption) (*quai.DeploymentList, error) {
+	res, err := client.listDeployments(ctx, listDeploymentsReq{Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentsRes := res.(listDeploymentsRes)
+	list := &quai.DeploymentList{}
+	for _, deployment := range deploymentsRes.deployments {
+		list.Items = append(list.Items, toDeploymentMessage(deployment))
+	}
+	return list, deploymentsRes.err
+}
+
+func (client *grpcClient) DeletePersistentVolume(ctx context.Context, req *quai.DeletePersistentVolumeReq, _ ...grpc.CallOption) (*quai.PersistentVolumeName, error) {
+	pvReq := deletePVReq{
+		Name: req.Name, Options: fromDeleteOptionsMessage(req.Options),
+	}
+
+	res, err := client.deletePersistentVolume(ctx, pvReq)
+	if err != nil {
+		return nil, err
+	}
+
+	pvRes := res.(deleteRes)
+	return &quai.PersistentVolumeName{Value: pvRes.name}, pvRes.err
+}
+
+func (client *grpcClient) DeletePersistentVolumeClaim(ctx context.Context, req *quai.DeletePersistentVolumeClaimReq, _ ...grpc.CallOption) (*quai.PersistentVolumeClaimName, error) {
+	pvcReq := deletePVCReq{
+		Name: req.Name, Namespace: req.Namespace, Options: fromDeleteOptionsMessage(req.Options),
+	}
+
+	res, err := client.deletePersistentVolumeClaim(ctx, pvcReq)
+	if err != nil {
+		return nil, err
+	}
+
+	pvcRes := res.(deleteRes)
+	return &quai.PersistentVolumeClaimName{Value: pvcRes.name}, pvcRes.err
+}
+
+func (client *grpcClient) DeleteDeployment(ctx context.Context, req *quai.DeleteDeploymentReq, _ ...grpc.CallOption) (*quai.DeploymentName, error) {
+	deploymentReq := deleteDeploymentReq{
+		Name: req.Name, Namespace: req.Namespace, Options: fromDeleteOptionsMessage(req.Options),
+	}
+
+	res, err := client.deleteDeployment(ctx, deploymentReq)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentRes := res.(deleteRes)
+	return &quai.DeploymentName{Value: deploymentRes.name}, deploymentRes.err
+}
+
+func (client *grpcClient) UpdateDeployment(ctx context.Context, req *quai.DeploymentReq, _ ...grpc.CallOption) (*quai.DeploymentName, error) {
+	deploymentReq, err := decodeCreateDeploymentRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.updateDeployment(ctx, updateDeploymentReq(deploymentReq.(createDeploymentReq)))
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentRes := res.(createDeploymentRes)
+	return &quai.DeploymentName{Value: deploymentRes.name, Manifest: deploymentRes.manifest}, deploymentRes.err
+}
+
+func (client *grpcClient) ScaleDeployment(ctx context.Context, req *quai.ScaleDeploymentReq, _ ...grpc.CallOption) (*quai.DeploymentName, error) {
+	scaleReq := scaleDeploymentReq{
+		Name: req.Name, Namespace: req.Namespace, Replicas: req.Replicas,
+	}
+
+	res, err := client.scaleDeployment(ctx, scaleReq)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentRes := res.(createDeploymentRes)
+	return &quai.DeploymentName{Value: deploymentRes.name, Manifest: deploymentRes.manifest}, deploymentRes.err
+}
+
+func (client *grpcClient) CreateJob(ctx context.Context, req *quai.JobReq, _ ...grpc.CallOption) (*quai.JobName, error) {
+	jobReq, err := decodeCreateJobRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.createJob(ctx, jobReq)
+	if err != nil {
+		return nil, err
+	}
+
+	jobRes := res.(createJobRes)
+	return &quai.JobName{Value: jobRes.name, Manifest: jobRes.manifest}, jobRes.err
+}
+
+func (client *grpcClient) GetNodeMetrics(ctx context.Context, req *quai.NodeMetricsReq, _ ...grpc.CallOption) (*quai.NodeMetricsList, error) {
+	res, err := client.getNodeMetrics(ctx, nodeMetricsReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	metricsRes := res.(nodeMetricsRes)
+	list := &quai.NodeMetricsList{}
+	for _, node := range metricsRes.nodes {
+		list.Items = append(list.Items, toNodeMetricsMessage(node))
+	}
+	return list, metricsRes.err
+}
+
+func (client *grpcClient) GetPodMetrics(ctx context.Context, req *quai.PodMetricsReq, _ ...grpc.CallOption) (*quai.PodMetricsList, error) {
+	res, err := client.getPodMetrics(ctx, podMetricsReq{Name: req.Name, Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	metricsRes := res.(podMetricsRes)
+	list := &quai.PodMetricsList{}
+	for _, pod := range metricsRes.pods {
+		list.Items = append(list.Items, toPodMetricsMessage(pod))
+	}
+	return list, metricsRes.err
+}
+
+func (client *grpcClient) GetClusterCapacity(ctx context.Context, req *quai.ClusterCapacityReq, _ ...grpc.CallOption) (*quai.ClusterCapacity, error) {
+	res, err := client.getClusterCapacity(ctx, clusterCapacityReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	capacityRes := res.(clusterCapacityRes)
+	capacity := &quai.ClusterCapacity{}
+	for _, node := range capacityRes.nodes {
+		capacity.Nodes = append(capacity.Nodes, toNodeCapacityMessage(node))
+	}
+	return capacity, capacityRes.err
+}
+
+func (client *grpcClient) CanSchedule(ctx context.Context, req *quai.DeploymentReq, _ ...grpc.CallOption) (*quai.ScheduleResult, error) {
+	deploymentReq, err := decodeCreateDeploymentRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.canSchedule(ctx, deploymentReq)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleRes := res.(canScheduleRes)
+	return toScheduleResultMessage(scheduleRes.result), scheduleRes.err
+}
+
+func (client *grpcClient) CreateRegistryCredential(ctx context.Context, req *quai.RegistryCredentialReq, _ ...grpc.CallOption) (*quai.RegistryCredentialName, error) {
+	credReq, err := decodeCreateRegistryCredentialRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.createRegistryCredential(ctx, credReq)
+	if err != nil {
+		return nil, err
+	}
+
+	credRes := res.(createRegistryCredentialRes)
+	return &quai.RegistryCredentialName{Value: credRes.name, Manifest: credRes.manifest}, credRes.err
+}
+
+func (client *grpcClient) ListRegistryCredentials(ctx context.Context, req *quai.ListRegistryCredentialsReq, _ ...grpc.CallOption) (*quai.RegistryCredentialList, error) {
+	res, err := client.listRegistryCredentials(ctx, listRegistryCredentialsReq{Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	credsRes := res.(listRegistryCredentialsRes)
+	list := &quai.RegistryCredentialList{}
+	for _, cred := range credsRes.creds {
+		list.Items = append(list.Items, toRegistryCredentialMessage(cred))
+	}
+	return list, credsRes.err
+}
+
+func (client *grpcClient) DeleteRegistryCredential(ctx context.Context, req *quai.DeleteRegistryCredentialReq, _ ...grpc.CallOption) (*quai.RegistryCredentialName, error) {
+	credReq := deleteRegistryCredentialReq{
+		Name: req.Name, Namespace: req.Namespace, Options: fromDeleteOptionsMessage(req.Options),
+	}
+
+	res, err := client.deleteRegistryCredential(ctx, credReq)
+	if err != nil {
+		return nil, err
+	}
+
+	credRes := res.(deleteRes)
+	return &quai.RegistryCredentialName{Value: credRes.name}, credRes.err
+}
+
+func (client *grpcClient) ExposeDeployment(ctx context.Context, req *quai.ExposeReq, _ ...grpc.CallOption) (*quai.ServiceName, error) {
+	exposeReq, err := decodeExposeRequest(ctx, req)
+	if err != nil {
+		return nil, err
 	}
+
+	res, err := client.exposeDeployment(ctx, exposeReq)
+	if err != nil {
+		return nil, err
+	}
+
+	svcRes := res.(exposeRes)
+	return &quai.ServiceName{Value: svcRes.name}, svcRes.err
 }
 
-func (client *grpcClient) CreateNFSPersistentVolume(ctx context.Context, req *quai.NFSPersistentVolumeReq, _ ...grpc.CallOption) (*quai.PersistentVolumeName, error) {
-	pvReq := createNFSPVReq{
-		Name: req.Name, Storage: req.Storage, Server: req.Server, Path: req.Server,
+func (client *grpcClient) CreateConfigMap(ctx context.Context, req *quai.ConfigMapReq, _ ...grpc.CallOption) (*quai.ConfigMapName, error) {
+	configMapReq, err := decodeCreateConfigMapRequest(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := client.createNFSPersistentVolume(ctx, pvReq)
+	res, err := client.createConfigMap(ctx, configMapReq)
 	if err != nil {
 		return nil, err
 	}
 
-	pvRes := res.(createPVRes)
-	return &quai.PersistentVolumeName{Value: pvRes.name}, pvRes.err
+	configMapRes := res.(createConfigMapRes)
+	return &quai.ConfigMapName{Value: configMapRes.name, Manifest: configMapRes.manifest}, configMapRes.err
 }
 
-func (client *grpcClient) CreatePersistentVolumeClaim(ctx context.Context, req *quai.PersistentVolumeClaimReq, _ ...grpc.CallOption) (*quai.PersistentVolumeClaimName, error) {
-	pvcReq := createPVCReq{
-		Name: req.Name, Storage: req.Storage,
+func (client *grpcClient) GetConfigMap(ctx context.Context, req *quai.GetConfigMapReq, _ ...grpc.CallOption) (*quai.ConfigMap, error) {
+	res, err := client.getConfigMap(ctx, getConfigMapReq{Name: req.Name, Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	configMapRes := res.(getConfigMapRes)
+	return toConfigMapMessage(configMapRes.configMap), configMapRes.err
+}
+
+func (client *grpcClient) UpdateConfigMap(ctx context.Context, req *quai.ConfigMapReq, _ ...grpc.CallOption) (*quai.ConfigMapName, error) {
+	configMapReq, err := decodeCreateConfigMapRequest(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := client.createNFSPersistentVolume(ctx, pvcReq)
+	res, err := client.updateConfigMap(ctx, configMapReq)
 	if err != nil {
 		return nil, err
 	}
 
-	pvcRes := res.(createPVCRes)
-	return &quai.PersistentVolumeClaimName{Value: pvcRes.name}, pvcRes.err
+	configMapRes := res.(createConfigMapRes)
+	return &quai.ConfigMapName{Value: configMapRes.name, Manifest: configMapRes.manifest}, configMapRes.err
 }
 
-func (client *grpcClient) CreateDeployment(ctx context.Context, req *quai.DeploymentReq, _ ...grpc.CallOption) (*quai.DeploymentName, error) {
-	resource := Resource{}
-	if req.Resource != nil {
-		resource.Memory = req.Resource.Memory
-		resource.CPU = req.Resource.CPU
-		resource.GPU = req.Resource.GPU
+func (client *grpcClient) DeleteConfigMap(ctx context.Context, req *quai.DeleteConfigMapReq, _ ...grpc.CallOption) (*quai.ConfigMapName, error) {
+	configMapReq := deleteConfigMapReq{
+		Name: req.Name, Namespace: req.Namespace, Options: fromDeleteOptionsMessage(req.Options),
 	}
-	var volumes []*VolumeInfo
-	for _, volume := range req.Volumes {
-		volumes = append(volumes, &VolumeInfo{
-			Name:      volume.Name,
-			PVCName:   volume.PVCName,
-			MountPath: volume.MountPath,
-		})
+
+	res, err := client.deleteConfigMap(ctx, configMapReq)
+	if err != nil {
+		return nil, err
 	}
-	deploymentReq := createDeploymentReq{
-			Name:      req.Name,
-			Replicas:  req.Replicas,
-			Image:     req.Image,
-			Resource:  &resource,
-			Volumes:   volumes,
-			Command:   req.Command,
-			Arguments: req.Arguments,
+
+	configMapRes := res.(deleteRes)
+	return &quai.ConfigMapName{Value: configMapRes.name}, configMapRes.err
+}
+
+func (client *grpcClient) CreateSecret(ctx context.Context, req *quai.SecretReq, _ ...grpc.CallOption) (*quai.SecretName, error) {
+	secretReq, err := decodeCreateSecretRequest(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := client.createDeployment(ctx, deploymentReq)
+	res, err := client.createSecret(ctx, secretReq)
 	if err != nil {
 		return nil, err
 	}
 
-	deploymentRes := res.(createDeploymentRes)
-	return &quai.DeploymentName{Value: deploymentRes.name}, deploymentRes.err
+	secretRes := res.(createSecretRes)
+	return &quai.SecretName{Value: secretRes.name, Manifest: secretRes.manifest}, secretRes.err
+}
+
+func (client *grpcClient) GetSecret(ctx context.Context, req *quai.GetSecretReq, _ ...grpc.CallOption) (*quai.Secret, error) {
+	res, err := client.getSecret(ctx, getSecretReq{Name: req.Name, Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	secretRes := res.(getSecretRes)
+	return toSecretMessage(secretRes.secret), secretRes.err
+}
+
+func (client *grpcClient) UpdateSecret(ctx context.Context, req *quai.SecretReq, _ ...grpc.CallOption) (*quai.SecretName, error) {
+	secretReq, err := decodeCreateSecretRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.updateSecret(ctx, secretReq)
+	if err != nil {
+		return nil, err
+	}
+
+	secretRes := res.(createSecretRes)
+	return &quai.SecretName{Value: secretRes.name, Manifest: secretRes.manifest}, secretRes.err
+}
+
+func (client *grpcClient) DeleteSecret(ctx context.Context, req *quai.DeleteSecretReq, _ ...grpc.CallOption) (*quai.SecretName, error) {
+	secretReq := deleteSecretReq{
+		Name: req.Name, Namespace: req.Namespace, Options: fromDeleteOptionsMessage(req.Options),
+	}
+
+	res, err := client.deleteSecret(ctx, secretReq)
+	if err != nil {
+		return nil, err
+	}
+
+	secretRes := res.(deleteRes)
+	return &quai.SecretName{Value: secretRes.name}, secretRes.err
+}
+
+func (client *grpcClient) CreateProject(ctx context.Context, req *quai.ProjectReq, _ ...grpc.CallOption) (*quai.ProjectName, error) {
+	projectReq, err := decodeCreateProjectRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.createProject(ctx, projectReq)
+	if err != nil {
+		return nil, err
+	}
+
+	projectRes := res.(createProjectRes)
+	return &quai.ProjectName{Value: projectRes.name}, projectRes.err
+}
+
+func (client *grpcClient) GetProject(ctx context.Context, req *quai.GetProjectReq, _ ...grpc.CallOption) (*quai.Project, error) {
+	res, err := client.getProject(ctx, getProjectReq{Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	projectRes := res.(getProjectRes)
+	return toProjectMessage(projectRes.project), projectRes.err
+}
+
+func (client *grpcClient) UpdateProject(ctx context.Context, req *quai.ProjectReq, _ ...grpc.CallOption) (*quai.ProjectName, error) {
+	projectReq, err := decodeCreateProjectRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.updateProject(ctx, projectReq)
+	if err != nil {
+		return nil, err
+	}
+
+	projectRes := res.(createProjectRes)
+	return &quai.ProjectName{Value: projectRes.name}, projectRes.err
+}
+
+func (client *grpcClient) DeleteProject(ctx context.Context, req *quai.DeleteProjectReq, _ ...grpc.CallOption) (*quai.ProjectName, error) {
+	projectReq := deleteProjectReq{
+		Name: req.Name, Options: fromDeleteOptionsMessage(req.Options),
+	}
+
+	res, err := client.deleteProject(ctx, projectReq)
+	if err != nil {
+		return nil, err
+	}
+
+	projectRes := res.(deleteRes)
+	return &quai.ProjectName{Value: projectRes.name}, projectRes.err
+}
+
+// WatchDeployment, WatchJob and StreamLogs are server-streaming RPCs, which
+// go-kit endpoints can't express, so they're served by the generated client.
+func (client *grpcClient) WatchDeployment(ctx context.Context, req *quai.WatchReq, opts ...grpc.CallOption) (quai.K8SClientService_WatchDeploymentClient, error) {
+	return client.streams.WatchDeployment(ctx, req, opts...)
+}
+
+func (client *grpcClient) WatchJob(ctx context.Context, req *quai.WatchReq, opts ...grpc.CallOption) (quai.K8SClientService_WatchJobClient, error) {
+	return client.streams.WatchJob(ctx, req, opts...)
 }
 
+func (client *grpcClient) StreamLogs(ctx context.Context, req *quai.LogsReq, opts ...grpc.CallOption) (quai.K8SClientService_StreamLogsClient, error) {
+	return client.streams.StreamLogs(ctx, req, opts...)
+}
 
 func encodeCreateNFSPVRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(createNFSPVReq)
-	return &quai.NFSPersistentVolumeReq{Name: req.Name, Storage: req.Storage, Server:req.Server, Path: req.Path}, nil
+	return &quai.NFSPersistentVolumeReq{
+		Name: req.Name, Storage: req.Storage, Server: req.Server, Path: req.Path,
+		AccessModes: req.AccessModes, StorageClassName: req.StorageClassName, Labels: req.Labels,
+		Options: toCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeCreatePVRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(createPVReq)
+	return &quai.PersistentVolumeReq{
+		Name:             req.PersistentVolume.Name,
+		Storage:          req.PersistentVolume.Storage,
+		AccessModes:      req.PersistentVolume.AccessModes,
+		StorageClassName: req.PersistentVolume.StorageClassName,
+		Labels:           req.PersistentVolume.Labels,
+		Source:           toVolumeSourceMessage(req.PersistentVolume.Source),
+		Options:          toCreateOptionsMessage(req.Options),
+	}, nil
 }
 
 func encodeCreatePVCRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(createPVCReq)
-	return &quai.PersistentVolumeClaimReq{Name: req.Name, Storage: req.Storage}, nil
+	return &quai.PersistentVolumeClaimReq{
+		Name:             req.Name,
+		Namespace:        req.Namespace,
+		Storage:          req.Storage,
+		StorageClassName: req.StorageClassName,
+		AccessModes:      req.AccessModes,
+		VolumeMode:       req.VolumeMode,
+		VolumeName:       req.VolumeName,
+		Selector:         req.Selector,
+		Options:          toCreateOptionsMessage(req.Options),
+	}, nil
 }
 
 func encodeCreateDeploymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
@@ -128,37 +912,428 @@ func encodeCreateDeploymentRequest(_ context.Context, grpcReq interface{}) (inte
 		resource.Memory = req.Resource.Memory
 		resource.CPU = req.Resource.CPU
 		resource.GPU = req.Resource.GPU
+		resource.Requests = toResourceQuantitiesMessage(req.Resource.Requests)
+		resource.Limits = toResourceQuantitiesMessage(req.Resource.Limits)
 	}
 	var volumes []*quai.VolumeInfo
 	for _, volume := range req.Volumes {
 		volumes = append(volumes, &quai.VolumeInfo{
-			Name:      volume.Name,
-			PVCName:   volume.PVCName,
-			MountPath: volume.MountPath,
+			Name:          volume.Name,
+			PVCName:       volume.PVCName,
+			SecretName:    volume.SecretName,
+			ConfigMapName: volume.ConfigMapName,
+			MountPath:     volume.MountPath,
+			ReadOnly:      volume.ReadOnly,
 		})
 	}
 	return &quai.DeploymentReq{
 		Name:      req.Name,
+		Namespace: req.Namespace,
 		Replicas:  req.Replicas,
 		Image:     req.Image,
 		Resource:  &resource,
 		Volumes:   volumes,
 		Command:   req.Command,
 		Arguments: req.Arguments,
+		Env:       toEnvMessages(req.Env),
+		EnvFrom:   toEnvFromMessages(req.EnvFrom),
+		Ports:     toContainerPortMessages(req.Ports),
+
+		ImagePullSecrets: req.ImagePullSecrets,
+
+		NodeSelector:      req.NodeSelector,
+		Tolerations:       toTolerationMessages(req.Tolerations),
+		Affinity:          toAffinityMessage(req.Affinity),
+		PriorityClassName: req.PriorityClassName,
+		SchedulerName:     req.SchedulerName,
+
+		Options: toCreateOptionsMessage(req.Options),
 	}, nil
 }
 
 func decodeCreateNFSPVResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(*quai.PersistentVolumeName)
-	return createPVRes{name: res.GetValue(), err: nil}, nil
+	return createPVRes{name: res.GetValue(), manifest: res.GetManifest(), err: nil}, nil
 }
 
 func decodeCreatePVCResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(*quai.PersistentVolumeClaimName)
-	return createPVCRes{name: res.GetValue(), err: nil}, nil
+	return createPVCRes{name: res.GetValue(), manifest: res.GetManifest(), err: nil}, nil
 }
 
 func decodeCreateDeploymentResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(*quai.DeploymentName)
-	return createDeploymentRes{name: res.GetValue(), err: nil}, nil
+	return createDeploymentRes{name: res.GetValue(), manifest: res.GetManifest(), err: nil}, nil
+}
+
+func encodeGetPVRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(getPVReq)
+	return &quai.GetPersistentVolumeReq{Name: req.Name}, nil
+}
+
+func decodeGetPVResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.PersistentVolume)
+	return getPVRes{pv: fromPVMessage(res), err: nil}, nil
+}
+
+func encodeListPVsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return &quai.ListPersistentVolumesReq{}, nil
+}
+
+func decodeListPVsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.PersistentVolumeList)
+	pvs := []k8s_client.PersistentVolumeStatus{}
+	for _, pv := range res.GetItems() {
+		pvs = append(pvs, fromPVMessage(pv))
+	}
+	return listPVsRes{pvs: pvs, err: nil}, nil
+}
+
+func encodeGetPVCRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(getPVCReq)
+	return &quai.GetPersistentVolumeClaimReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func decodeGetPVCResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.PersistentVolumeClaim)
+	return getPVCRes{pvc: fromPVCMessage(res), err: nil}, nil
+}
+
+func encodeListPVCsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(listPVCsReq)
+	return &quai.ListPersistentVolumeClaimsReq{Namespace: req.Namespace}, nil
+}
+
+func decodeListPVCsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.PersistentVolumeClaimList)
+	pvcs := []k8s_client.PersistentVolumeClaimStatus{}
+	for _, pvc := range res.GetItems() {
+		pvcs = append(pvcs, fromPVCMessage(pvc))
+	}
+	return listPVCsRes{pvcs: pvcs, err: nil}, nil
+}
+
+func encodeGetDeploymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(getDeploymentReq)
+	return &quai.GetDeploymentReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func decodeGetDeploymentResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.Deployment)
+	return getDeploymentRes{deployment: fromDeploymentMessage(res), err: nil}, nil
+}
+
+func encodeListDeploymentsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(listDeploymentsReq)
+	return &quai.ListDeploymentsReq{Namespace: req.Namespace}, nil
+}
+
+func decodeListDeploymentsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.DeploymentList)
+	deployments := []k8s_client.DeploymentStatus{}
+	for _, deployment := range res.GetItems() {
+		deployments = append(deployments, fromDeploymentMessage(deployment))
+	}
+	return listDeploymentsRes{deployments: deployments, err: nil}, nil
+}
+
+func encodeDeletePVRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(deletePVReq)
+	return &quai.DeletePersistentVolumeReq{Name: req.Name, Options: toDeleteOptionsMessage(req.Options)}, nil
+}
+
+func decodeDeletePVResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.PersistentVolumeName)
+	return deleteRes{name: res.GetValue(), err: nil}, nil
+}
+
+func encodeDeletePVCRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(deletePVCReq)
+	return &quai.DeletePersistentVolumeClaimReq{Name: req.Name, Namespace: req.Namespace, Options: toDeleteOptionsMessage(req.Options)}, nil
+}
+
+func decodeDeletePVCResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.PersistentVolumeClaimName)
+	return deleteRes{name: res.GetValue(), err: nil}, nil
+}
+
+func encodeDeleteDeploymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(deleteDeploymentReq)
+	return &quai.DeleteDeploymentReq{Name: req.Name, Namespace: req.Namespace, Options: toDeleteOptionsMessage(req.Options)}, nil
+}
+
+func decodeDeleteDeploymentResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.DeploymentName)
+	return deleteRes{name: res.GetValue(), err: nil}, nil
+}
+
+func encodeUpdateDeploymentRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(updateDeploymentReq)
+	return encodeCreateDeploymentRequest(ctx, createDeploymentReq(req))
+}
+
+func encodeScaleDeploymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(scaleDeploymentReq)
+	return &quai.ScaleDeploymentReq{Name: req.Name, Namespace: req.Namespace, Replicas: req.Replicas}, nil
+}
+
+func encodeCreateJobRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(createJobReq)
+	resource := quai.Resource{}
+	if req.Resource != nil {
+		resource.Memory = req.Resource.Memory
+		resource.CPU = req.Resource.CPU
+		resource.GPU = req.Resource.GPU
+		resource.Requests = toResourceQuantitiesMessage(req.Resource.Requests)
+		resource.Limits = toResourceQuantitiesMessage(req.Resource.Limits)
+	}
+	var volumes []*quai.VolumeInfo
+	for _, volume := range req.Volumes {
+		volumes = append(volumes, &quai.VolumeInfo{
+			Name:          volume.Name,
+			PVCName:       volume.PVCName,
+			SecretName:    volume.SecretName,
+			ConfigMapName: volume.ConfigMapName,
+			MountPath:     volume.MountPath,
+			ReadOnly:      volume.ReadOnly,
+		})
+	}
+	return &quai.JobReq{
+		Name:                    req.Name,
+		Namespace:               req.Namespace,
+		Image:                   req.Image,
+		Resource:                &resource,
+		Volumes:                 volumes,
+		Command:                 req.Command,
+		Arguments:               req.Arguments,
+		Env:                     toEnvMessages(req.Env),
+		EnvFrom:                 toEnvFromMessages(req.EnvFrom),
+		BackoffLimit:            toInt32Value(req.BackoffLimit),
+		ActiveDeadlineSeconds:   toInt64Value(req.ActiveDeadlineSeconds),
+		Completions:             toInt32Value(req.Completions),
+		Parallelism:             toInt32Value(req.Parallelism),
+		TTLSecondsAfterFinished: toInt32Value(req.TTLSecondsAfterFinished),
+
+		ImagePullSecrets: req.ImagePullSecrets,
+
+		NodeSelector:      req.NodeSelector,
+		Tolerations:       toTolerationMessages(req.Tolerations),
+		Affinity:          toAffinityMessage(req.Affinity),
+		PriorityClassName: req.PriorityClassName,
+		SchedulerName:     req.SchedulerName,
+
+		Options: toCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func decodeCreateJobResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.JobName)
+	return createJobRes{name: res.GetValue(), manifest: res.GetManifest(), err: nil}, nil
+}
+
+func encodeGetNodeMetricsRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return &quai.NodeMetricsReq{}, nil
+}
+
+func decodeGetNodeMetricsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.NodeMetricsList)
+	nodes := []k8s_client.NodeMetrics{}
+	for _, node := range res.GetItems() {
+		nodes = append(nodes, fromNodeMetricsMessage(node))
+	}
+	return nodeMetricsRes{nodes: nodes, err: nil}, nil
+}
+
+func encodeGetPodMetricsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(podMetricsReq)
+	return &quai.PodMetricsReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func decodeGetPodMetricsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.PodMetricsList)
+	pods := []k8s_client.PodMetrics{}
+	for _, pod := range res.GetItems() {
+		pods = append(pods, fromPodMetricsMessage(pod))
+	}
+	return podMetricsRes{pods: pods, err: nil}, nil
+}
+
+func encodeGetClusterCapacityRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return &quai.ClusterCapacityReq{}, nil
+}
+
+func decodeGetClusterCapacityResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.ClusterCapacity)
+	nodes := []k8s_client.NodeCapacity{}
+	for _, node := range res.GetNodes() {
+		nodes = append(nodes, fromNodeCapacityMessage(node))
+	}
+	return clusterCapacityRes{nodes: nodes, err: nil}, nil
+}
+
+func decodeCanScheduleResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.ScheduleResult)
+	return canScheduleRes{result: fromScheduleResultMessage(res), err: nil}, nil
+}
+
+func encodeCreateRegistryCredentialRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(createRegistryCredentialReq)
+	return &quai.RegistryCredentialReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Server:    req.Server,
+		Username:  req.Username,
+		Token:     req.Token,
+		Options:   toCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func decodeCreateRegistryCredentialResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.RegistryCredentialName)
+	return createRegistryCredentialRes{name: res.GetValue(), manifest: res.GetManifest(), err: nil}, nil
+}
+
+func encodeListRegistryCredentialsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(listRegistryCredentialsReq)
+	return &quai.ListRegistryCredentialsReq{Namespace: req.Namespace}, nil
+}
+
+func decodeListRegistryCredentialsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.RegistryCredentialList)
+	creds := []k8s_client.RegistryCredentialStatus{}
+	for _, cred := range res.GetItems() {
+		creds = append(creds, fromRegistryCredentialMessage(cred))
+	}
+	return listRegistryCredentialsRes{creds: creds, err: nil}, nil
+}
+
+func encodeDeleteRegistryCredentialRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(deleteRegistryCredentialReq)
+	return &quai.DeleteRegistryCredentialReq{Name: req.Name, Namespace: req.Namespace, Options: toDeleteOptionsMessage(req.Options)}, nil
+}
+
+func decodeDeleteRegistryCredentialResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.RegistryCredentialName)
+	return deleteRes{name: res.GetValue(), err: nil}, nil
+}
+
+func encodeExposeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(exposeReq)
+	return &quai.ExposeReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Type:      req.Type,
+		Ports:     toServicePortMessages(req.Ports),
+		Ingress:   toIngressRuleMessage(req.Ingress),
+	}, nil
+}
+
+func decodeExposeResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.ServiceName)
+	return exposeRes{name: res.GetValue(), err: nil}, nil
+}
+
+func encodeCreateConfigMapRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(createConfigMapReq)
+	return &quai.ConfigMapReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Data:      req.Data,
+		Options:   toCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func decodeCreateConfigMapResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.ConfigMapName)
+	return createConfigMapRes{name: res.GetValue(), manifest: res.GetManifest(), err: nil}, nil
+}
+
+func encodeGetConfigMapRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(getConfigMapReq)
+	return &quai.GetConfigMapReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func decodeGetConfigMapResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.ConfigMap)
+	return getConfigMapRes{configMap: fromConfigMapMessage(res), err: nil}, nil
+}
+
+func encodeDeleteConfigMapRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(deleteConfigMapReq)
+	return &quai.DeleteConfigMapReq{Name: req.Name, Namespace: req.Namespace, Options: toDeleteOptionsMessage(req.Options)}, nil
+}
+
+func decodeDeleteConfigMapResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.ConfigMapName)
+	return deleteRes{name: res.GetValue(), err: nil}, nil
+}
+
+func encodeCreateSecretRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(createSecretReq)
+	return &quai.SecretReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Type:      req.Type,
+		Data:      req.Data,
+		Options:   toCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func decodeCreateSecretResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.SecretName)
+	return createSecretRes{name: res.GetValue(), manifest: res.GetManifest(), err: nil}, nil
+}
+
+func encodeGetSecretRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(getSecretReq)
+	return &quai.GetSecretReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func decodeGetSecretResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.Secret)
+	return getSecretRes{secret: fromSecretMessage(res), err: nil}, nil
+}
+
+func encodeDeleteSecretRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(deleteSecretReq)
+	return &quai.DeleteSecretReq{Name: req.Name, Namespace: req.Namespace, Options: toDeleteOptionsMessage(req.Options)}, nil
+}
+
+func decodeDeleteSecretResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.SecretName)
+	return deleteRes{name: res.GetValue(), err: nil}, nil
+}
+
+func encodeCreateProjectRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(createProjectReq)
+	return &quai.ProjectReq{
+		Name:   req.Name,
+		Quota:  toProjectQuotaMessage(req.Quota),
+		Limits: toProjectLimitsMessage(req.Limits),
+	}, nil
+}
+
+func decodeCreateProjectResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.ProjectName)
+	return createProjectRes{name: res.GetValue(), err: nil}, nil
+}
+
+func encodeGetProjectRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(getProjectReq)
+	return &quai.GetProjectReq{Name: req.Name}, nil
+}
+
+func decodeGetProjectResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.Project)
+	return getProjectRes{project: fromProjectMessage(res), err: nil}, nil
+}
+
+func encodeDeleteProjectRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(deleteProjectReq)
+	return &quai.DeleteProjectReq{Name: req.Name, Options: toDeleteOptionsMessage(req.Options)}, nil
+}
+
+func decodeDeleteProjectResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*quai.ProjectName)
+	return deleteRes{name: res.GetValue(), err: nil}, nil
 }
@@ -13,16 +13,26 @@ func createNFSPVEndpoint(svc k8s_client.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		pvName, err := svc.CreateNFSPV(k8s_client.NFSPersistentVolume{
-			Name: req.Name,
-			Storage: req.Storage,
-			Server: req.Server,
-			Path: req.Path,
-		})
+		res, err := svc.CreateNFSPV(ctx, req.pv(), req.Options)
 		if err != nil {
 			return createPVRes{name: "", err: err}, err
 		}
-		return createPVRes{name: pvName, err: nil}, nil
+		return createPVRes{name: res.Name, manifest: res.Manifest, err: nil}, nil
+	}
+}
+
+func createPVEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createPVReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreatePV(ctx, req.PersistentVolume, req.Options)
+		if err != nil {
+			return createPVRes{name: "", err: err}, err
+		}
+		return createPVRes{name: res.Name, manifest: res.Manifest, err: nil}, nil
 	}
 }
 
@@ -33,14 +43,11 @@ func createPVCEndpoint(svc k8s_client.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		pvcName, err := svc.CreatePVC(k8s_client.PersistentVolumeClaim{
-			Name: req.Name,
-			Storage: req.Storage,
-		})
+		res, err := svc.CreatePVC(ctx, req.pvc(), req.Options)
 		if err != nil {
 			return createPVCRes{name: "", err: err}, err
 		}
-		return createPVCRes{name: pvcName, err: nil}, nil
+		return createPVCRes{name: res.Name, manifest: res.Manifest, err: nil}, nil
 	}
 }
 
@@ -51,33 +58,482 @@ func createDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		resource := k8s_client.Resource{}
-		if req.Resource != nil {
-			resource.Memory = req.Resource.Memory
-			resource.CPU = req.Resource.CPU
-			resource.GPU = req.Resource.GPU
+		res, err := svc.CreateDeployment(ctx, req.deployment(), req.Options)
+		if err != nil {
+			return createDeploymentRes{name: "", err: err}, err
+		}
+		return createDeploymentRes{name: res.Name, manifest: res.Manifest, err: nil}, nil
+	}
+}
+
+func getPVEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getPVReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pv, err := svc.GetPV(ctx, req.Name)
+		if err != nil {
+			return getPVRes{err: err}, err
+		}
+		return getPVRes{pv: pv, err: nil}, nil
+	}
+}
+
+func listPVsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listPVsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pvs, err := svc.ListPVs(ctx)
+		if err != nil {
+			return listPVsRes{err: err}, err
+		}
+		return listPVsRes{pvs: pvs, err: nil}, nil
+	}
+}
+
+func getPVCEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getPVCReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pvc, err := svc.GetPVC(ctx, req.Namespace, req.Name)
+		if err != nil {
+			return getPVCRes{err: err}, err
+		}
+		return getPVCRes{pvc: pvc, err: nil}, nil
+	}
+}
+
+func listPVCsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listPVCsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pvcs, err := svc.ListPVCs(ctx, req.Namespace)
+		if err != nil {
+			return listPVCsRes{err: err}, err
+		}
+		return listPVCsRes{pvcs: pvcs, err: nil}, nil
+	}
+}
+
+func getDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getDeploymentReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		deployment, err := svc.GetDeployment(ctx, req.Namespace, req.Name)
+		if err != nil {
+			return getDeploymentRes{err: err}, err
+		}
+		return getDeploymentRes{deployment: deployment, err: nil}, nil
+	}
+}
+
+func listDeploymentsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listDeploymentsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		deployments, err := svc.ListDeployments(ctx, req.Namespace)
+		if err != nil {
+			return listDeploymentsRes{err: err}, err
+		}
+		return listDeploymentsRes{deployments: deployments, err: nil}, nil
+	}
+}
+
+func deletePVEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deletePVReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeletePV(ctx, req.Name, req.Options); err != nil {
+			return deleteRes{name: "", err: err}, err
 		}
-		volumes := []*k8s_client.VolumeInfo{}
-		for _, volume := range req.Volumes {
-			volumes = append(volumes, &k8s_client.VolumeInfo{
-				Name:      volume.Name,
-				PVCName:   volume.PVCName,
-				MountPath: volume.MountPath,
-			})
+		return deleteRes{name: req.Name, err: nil}, nil
+	}
+}
+
+func deletePVCEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deletePVCReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeletePVC(ctx, req.Namespace, req.Name, req.Options); err != nil {
+			return deleteRes{name: "", err: err}, err
 		}
+		return deleteRes{name: req.Name, err: nil}, nil
+	}
+}
 
-		deployment, err := svc.CreateDeployment(k8s_client.Deployment{
-			Name:      req.Name,
-			Replicas:  req.Replicas,
-			Image:     req.Image,
-			Resource:  &resource,
-			Volumes:   volumes,
-			Command:   req.Command,
-			Arguments: req.Arguments,
-		})
+func deleteDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteDeploymentReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteDeployment(ctx, req.Namespace, req.Name, req.Options); err != nil {
+			return deleteRes{name: "", err: err}, err
+		}
+		return deleteRes{name: req.Name, err: nil}, nil
+	}
+}
+
+func updateDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateDeploymentReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		deployment, err := svc.UpdateDeployment(ctx, createDeploymentReq(req).deployment())
 		if err != nil {
 			return createDeploymentRes{name: "", err: err}, err
 		}
 		return createDeploymentRes{name: deployment, err: nil}, nil
 	}
 }
+
+func scaleDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(scaleDeploymentReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.ScaleDeployment(ctx, req.Namespace, req.Name, req.Replicas); err != nil {
+			return createDeploymentRes{name: "", err: err}, err
+		}
+		return createDeploymentRes{name: req.Name, err: nil}, nil
+	}
+}
+
+func createJobEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createJobReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreateJob(ctx, req.job(), req.Options)
+		if err != nil {
+			return createJobRes{name: "", err: err}, err
+		}
+		return createJobRes{name: res.Name, manifest: res.Manifest, err: nil}, nil
+	}
+}
+
+func getNodeMetricsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(nodeMetricsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		nodes, err := svc.GetNodeMetrics(ctx)
+		if err != nil {
+			return nodeMetricsRes{err: err}, err
+		}
+		return nodeMetricsRes{nodes: nodes, err: nil}, nil
+	}
+}
+
+func getPodMetricsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(podMetricsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pods, err := svc.GetPodMetrics(ctx, req.Namespace, req.Name)
+		if err != nil {
+			return podMetricsRes{err: err}, err
+		}
+		return podMetricsRes{pods: pods, err: nil}, nil
+	}
+}
+
+func getClusterCapacityEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(clusterCapacityReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		nodes, err := svc.ClusterCapacity(ctx)
+		if err != nil {
+			return clusterCapacityRes{err: err}, err
+		}
+		return clusterCapacityRes{nodes: nodes, err: nil}, nil
+	}
+}
+
+func canScheduleEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createDeploymentReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		result, err := svc.CanSchedule(ctx, req.deployment())
+		if err != nil {
+			return canScheduleRes{err: err}, err
+		}
+		return canScheduleRes{result: result, err: nil}, nil
+	}
+}
+
+func createRegistryCredentialEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createRegistryCredentialReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreateRegistryCredential(ctx, req.credential(), req.Options)
+		if err != nil {
+			return createRegistryCredentialRes{name: "", err: err}, err
+		}
+		return createRegistryCredentialRes{name: res.Name, manifest: res.Manifest, err: nil}, nil
+	}
+}
+
+func listRegistryCredentialsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listRegistryCredentialsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		creds, err := svc.ListRegistryCredentials(ctx, req.Namespace)
+		if err != nil {
+			return listRegistryCredentialsRes{err: err}, err
+		}
+		return listRegistryCredentialsRes{creds: creds, err: nil}, nil
+	}
+}
+
+func deleteRegistryCredentialEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteRegistryCredentialReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteRegistryCredential(ctx, req.Namespace, req.Name, req.Options); err != nil {
+			return deleteRes{name: "", err: err}, err
+		}
+		return deleteRes{name: req.Name, err: nil}, nil
+	}
+}
+
+func exposeDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(exposeReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.ExposeDeployment(ctx, req.exposure())
+		if err != nil {
+			return exposeRes{name: "", err: err}, err
+		}
+		return exposeRes{name: name, err: nil}, nil
+	}
+}
+
+func createConfigMapEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createConfigMapReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreateConfigMap(ctx, req.configMap(), req.Options)
+		if err != nil {
+			return createConfigMapRes{name: "", err: err}, err
+		}
+		return createConfigMapRes{name: res.Name, manifest: res.Manifest, err: nil}, nil
+	}
+}
+
+func getConfigMapEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getConfigMapReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		configMap, err := svc.GetConfigMap(ctx, req.Namespace, req.Name)
+		if err != nil {
+			return getConfigMapRes{err: err}, err
+		}
+		return getConfigMapRes{configMap: configMap, err: nil}, nil
+	}
+}
+
+func updateConfigMapEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createConfigMapReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.UpdateConfigMap(ctx, req.configMap())
+		if err != nil {
+			return createConfigMapRes{name: "", err: err}, err
+		}
+		return createConfigMapRes{name: name, err: nil}, nil
+	}
+}
+
+func deleteConfigMapEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteConfigMapReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteConfigMap(ctx, req.Namespace, req.Name, req.Options); err != nil {
+			return deleteRes{name: "", err: err}, err
+		}
+		return deleteRes{name: req.Name, err: nil}, nil
+	}
+}
+
+func createSecretEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createSecretReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreateSecret(ctx, req.secret(), req.Options)
+		if err != nil {
+			return createSecretRes{name: "", err: err}, err
+		}
+		return createSecretRes{name: res.Name, manifest: res.Manifest, err: nil}, nil
+	}
+}
+
+func getSecretEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getSecretReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		secret, err := svc.GetSecret(ctx, req.Namespace, req.Name)
+		if err != nil {
+			return getSecretRes{err: err}, err
+		}
+		return getSecretRes{secret: secret, err: nil}, nil
+	}
+}
+
+func updateSecretEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createSecretReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.UpdateSecret(ctx, req.secret())
+		if err != nil {
+			return createSecretRes{name: "", err: err}, err
+		}
+		return createSecretRes{name: name, err: nil}, nil
+	}
+}
+
+func deleteSecretEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteSecretReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteSecret(ctx, req.Namespace, req.Name, req.Options); err != nil {
+			return deleteRes{name: "", err: err}, err
+		}
+		return deleteRes{name: req.Name, err: nil}, nil
+	}
+}
+
+func createProjectEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createProjectReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.CreateProject(ctx, req.project())
+		if err != nil {
+			return createProjectRes{name: "", err: err}, err
+		}
+		return createProjectRes{name: name, err: nil}, nil
+	}
+}
+
+func getProjectEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getProjectReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		project, err := svc.GetProject(ctx, req.Name)
+		if err != nil {
+			return getProjectRes{err: err}, err
+		}
+		return getProjectRes{project: project, err: nil}, nil
+	}
+}
+
+func updateProjectEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createProjectReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.UpdateProject(ctx, req.project())
+		if err != nil {
+			return createProjectRes{name: "", err: err}, err
+		}
+		return createProjectRes{name: name, err: nil}, nil
+	}
+}
+
+func deleteProjectEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteProjectReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteProject(ctx, req.Name, req.Options); err != nil {
+			return deleteRes{name: "", err: err}, err
+		}
+		return deleteRes{name: req.Name, err: nil}, nil
+	}
+}
@@ -5,57 +5,643 @@ import (
 )
 
 type createNFSPVReq struct {
-	Name                 string
-	Storage              string
-	Server               string
-	Path                 string
+	Name             string
+	Storage          string
+	Server           string
+	Path             string
+	AccessModes      []string
+	StorageClassName string
+	Labels           map[string]string
+	Options          k8s_client.CreateOptions
 }
 
 func (req createNFSPVReq) validate() error {
-	if req.Name == "" || req.Storage == "" || req.Server == "" || req.Path == "" {
-		return k8s_client.ErrMalformedEntity
+	if err := req.pv().Validate(); err != nil {
+		return err
+	}
+	return req.Options.Validate()
+}
+
+func (req createNFSPVReq) pv() k8s_client.NFSPersistentVolume {
+	return k8s_client.NFSPersistentVolume{
+		Name:             req.Name,
+		Storage:          req.Storage,
+		Server:           req.Server,
+		Path:             req.Path,
+		AccessModes:      req.AccessModes,
+		StorageClassName: req.StorageClassName,
+		Labels:           req.Labels,
 	}
-	return nil
 }
 
 type createPVCReq struct {
-	Name                 string
-	Storage              string
+	Name             string
+	Namespace        string
+	Storage          string
+	StorageClassName string
+	AccessModes      []string
+	VolumeMode       string
+	VolumeName       string
+	Selector         map[string]string
+	Options          k8s_client.CreateOptions
 }
 
 func (req createPVCReq) validate() error {
-	if req.Name == "" || req.Storage == "" {
-		return k8s_client.ErrMalformedEntity
+	if err := req.Options.Validate(); err != nil {
+		return err
+	}
+
+	return req.pvc().Validate()
+}
+
+func (req createPVCReq) pvc() k8s_client.PersistentVolumeClaim {
+	return k8s_client.PersistentVolumeClaim{
+		Name:             req.Name,
+		Namespace:        req.Namespace,
+		Storage:          req.Storage,
+		StorageClassName: req.StorageClassName,
+		AccessModes:      req.AccessModes,
+		VolumeMode:       req.VolumeMode,
+		VolumeName:       req.VolumeName,
+		Selector:         req.Selector,
 	}
-	return nil
 }
 
 type Resource struct {
-	CPU    string
-	Memory string
-	GPU    string
+	CPU      string
+	Memory   string
+	GPU      string
+	Requests *k8s_client.ResourceQuantities
+	Limits   *k8s_client.ResourceQuantities
 }
 
 type VolumeInfo struct {
-	Name      string
-	PVCName   string
-	MountPath string
+	Name          string
+	PVCName       string
+	SecretName    string
+	ConfigMapName string
+	MountPath     string
+	ReadOnly      bool
 }
 
 type createDeploymentReq struct {
 	Name      string
+	Namespace string
 	Replicas  int32
 	Image     string
 	Resource  *Resource
 	Volumes   []*VolumeInfo
 	Command   []string
 	Arguments []string
+	Env       []*k8s_client.EnvVar
+	EnvFrom   []*k8s_client.EnvFromSource
+	Ports     []*k8s_client.ContainerPort
+
+	ImagePullSecrets []string
+
+	NodeSelector      map[string]string
+	Tolerations       []*k8s_client.Toleration
+	Affinity          *k8s_client.Affinity
+	PriorityClassName string
+	SchedulerName     string
+
+	Options k8s_client.CreateOptions
 }
 
 func (req createDeploymentReq) validate() error {
-	if req.Name == "" || req.Image == "" {
+	if err := req.Options.Validate(); err != nil {
+		return err
+	}
+
+	return req.deployment().Validate()
+}
+
+func (req createDeploymentReq) deployment() k8s_client.Deployment {
+	resource := k8s_client.Resource{}
+	if req.Resource != nil {
+		resource.Memory = req.Resource.Memory
+		resource.CPU = req.Resource.CPU
+		resource.GPU = req.Resource.GPU
+		resource.Requests = req.Resource.Requests
+		resource.Limits = req.Resource.Limits
+	}
+	volumes := []*k8s_client.VolumeInfo{}
+	for _, volume := range req.Volumes {
+		volumes = append(volumes, &k8s_client.VolumeInfo{
+			Name:          volume.Name,
+			PVCName:       volume.PVCName,
+			SecretName:    volume.SecretName,
+			ConfigMapName: volume.ConfigMapName,
+			MountPath:     volume.MountPath,
+			ReadOnly:      volume.ReadOnly,
+		})
+	}
+
+	return k8s_client.Deployment{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Replicas:  req.Replicas,
+		Image:     req.Image,
+		Resource:  &resource,
+		Volumes:   volumes,
+		Command:   req.Command,
+		Arguments: req.Arguments,
+		Env:       req.Env,
+		EnvFrom:   req.EnvFrom,
+		Ports:     req.Ports,
+
+		ImagePullSecrets: req.ImagePullSecrets,
+
+		NodeSelector:      req.NodeSelector,
+		Tolerations:       req.Tolerations,
+		Affinity:          req.Affinity,
+		PriorityClassName: req.PriorityClassName,
+		SchedulerName:     req.SchedulerName,
+	}
+}
+
+// updateDeploymentReq carries the same fields as createDeploymentReq, but
+// only the deployment name is mandatory since empty fields are left as is.
+type updateDeploymentReq createDeploymentReq
+
+func (req updateDeploymentReq) validate() error {
+	return createDeploymentReq(req).deployment().ValidatePatch()
+}
+
+type scaleDeploymentReq struct {
+	Name      string
+	Namespace string
+	Replicas  int32
+}
+
+func (req scaleDeploymentReq) validate() error {
+	if req.Name == "" || req.Replicas < 0 {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type getPVReq struct {
+	Name string
+}
+
+func (req getPVReq) validate() error {
+	if req.Name == "" {
 		return k8s_client.ErrMalformedEntity
 	}
 
 	return nil
 }
+
+type listPVsReq struct{}
+
+func (req listPVsReq) validate() error {
+	return nil
+}
+
+type getPVCReq struct {
+	Name      string
+	Namespace string
+}
+
+func (req getPVCReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type listPVCsReq struct {
+	Namespace string
+}
+
+func (req listPVCsReq) validate() error {
+	return nil
+}
+
+type getDeploymentReq struct {
+	Name      string
+	Namespace string
+}
+
+func (req getDeploymentReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type listDeploymentsReq struct {
+	Namespace string
+}
+
+func (req listDeploymentsReq) validate() error {
+	return nil
+}
+
+type deletePVReq struct {
+	Name    string
+	Options k8s_client.DeleteOptions
+}
+
+func (req deletePVReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.Options.Validate()
+}
+
+type deletePVCReq struct {
+	Name      string
+	Namespace string
+	Options   k8s_client.DeleteOptions
+}
+
+func (req deletePVCReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.Options.Validate()
+}
+
+type deleteDeploymentReq struct {
+	Name      string
+	Namespace string
+	Options   k8s_client.DeleteOptions
+}
+
+func (req deleteDeploymentReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.Options.Validate()
+}
+
+type createJobReq struct {
+	Name                    string
+	Namespace               string
+	Image                   string
+	Resource                *Resource
+	Volumes                 []*VolumeInfo
+	Command                 []string
+	Arguments               []string
+	Env                     []*k8s_client.EnvVar
+	EnvFrom                 []*k8s_client.EnvFromSource
+	BackoffLimit            *int32
+	ActiveDeadlineSeconds   *int64
+	Completions             *int32
+	Parallelism             *int32
+	TTLSecondsAfterFinished *int32
+
+	ImagePullSecrets []string
+
+	NodeSelector      map[string]string
+	Tolerations       []*k8s_client.Toleration
+	Affinity          *k8s_client.Affinity
+	PriorityClassName string
+	SchedulerName     string
+
+	Options k8s_client.CreateOptions
+}
+
+func (req createJobReq) validate() error {
+	if err := req.Options.Validate(); err != nil {
+		return err
+	}
+
+	return req.job().Validate()
+}
+
+func (req createJobReq) job() k8s_client.Job {
+	resource := k8s_client.Resource{}
+	if req.Resource != nil {
+		resource.Memory = req.Resource.Memory
+		resource.CPU = req.Resource.CPU
+		resource.GPU = req.Resource.GPU
+		resource.Requests = req.Resource.Requests
+		resource.Limits = req.Resource.Limits
+	}
+	volumes := []*k8s_client.VolumeInfo{}
+	for _, volume := range req.Volumes {
+		volumes = append(volumes, &k8s_client.VolumeInfo{
+			Name:          volume.Name,
+			PVCName:       volume.PVCName,
+			SecretName:    volume.SecretName,
+			ConfigMapName: volume.ConfigMapName,
+			MountPath:     volume.MountPath,
+			ReadOnly:      volume.ReadOnly,
+		})
+	}
+
+	return k8s_client.Job{
+		Name:                    req.Name,
+		Namespace:               req.Namespace,
+		Image:                   req.Image,
+		Resource:                &resource,
+		Volumes:                 volumes,
+		Command:                 req.Command,
+		Arguments:               req.Arguments,
+		Env:                     req.Env,
+		EnvFrom:                 req.EnvFrom,
+		BackoffLimit:            req.BackoffLimit,
+		ActiveDeadlineSeconds:   req.ActiveDeadlineSeconds,
+		Completions:             req.Completions,
+		Parallelism:             req.Parallelism,
+		TTLSecondsAfterFinished: req.TTLSecondsAfterFinished,
+
+		ImagePullSecrets: req.ImagePullSecrets,
+
+		NodeSelector:      req.NodeSelector,
+		Tolerations:       req.Tolerations,
+		Affinity:          req.Affinity,
+		PriorityClassName: req.PriorityClassName,
+		SchedulerName:     req.SchedulerName,
+	}
+}
+
+type watchReq struct {
+	Name      string
+	Namespace string
+}
+
+func (req watchReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type logsReq struct {
+	Name      string
+	Namespace string
+	Options   k8s_client.LogOptions
+}
+
+func (req logsReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.Options.Validate()
+}
+
+type nodeMetricsReq struct{}
+
+func (req nodeMetricsReq) validate() error {
+	return nil
+}
+
+type podMetricsReq struct {
+	Name      string
+	Namespace string
+}
+
+func (req podMetricsReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type clusterCapacityReq struct{}
+
+func (req clusterCapacityReq) validate() error {
+	return nil
+}
+
+type createPVReq struct {
+	PersistentVolume k8s_client.PersistentVolume
+	Options          k8s_client.CreateOptions
+}
+
+func (req createPVReq) validate() error {
+	if err := req.Options.Validate(); err != nil {
+		return err
+	}
+
+	return req.PersistentVolume.Validate()
+}
+
+type createRegistryCredentialReq struct {
+	Name      string
+	Namespace string
+	Server    string
+	Username  string
+	Token     string
+	Options   k8s_client.CreateOptions
+}
+
+func (req createRegistryCredentialReq) validate() error {
+	if err := req.Options.Validate(); err != nil {
+		return err
+	}
+
+	return req.credential().Validate()
+}
+
+func (req createRegistryCredentialReq) credential() k8s_client.RegistryCredential {
+	return k8s_client.RegistryCredential{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Server:    req.Server,
+		Username:  req.Username,
+		Token:     req.Token,
+	}
+}
+
+type listRegistryCredentialsReq struct {
+	Namespace string
+}
+
+func (req listRegistryCredentialsReq) validate() error {
+	return nil
+}
+
+type deleteRegistryCredentialReq struct {
+	Name      string
+	Namespace string
+	Options   k8s_client.DeleteOptions
+}
+
+func (req deleteRegistryCredentialReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.Options.Validate()
+}
+
+type exposeReq struct {
+	Name      string
+	Namespace string
+	Type      string
+	Ports     []*k8s_client.ServicePort
+	Ingress   *k8s_client.IngressRule
+}
+
+func (req exposeReq) validate() error {
+	return req.exposure().Validate()
+}
+
+func (req exposeReq) exposure() k8s_client.Exposure {
+	return k8s_client.Exposure{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Type:      req.Type,
+		Ports:     req.Ports,
+		Ingress:   req.Ingress,
+	}
+}
+
+type createConfigMapReq struct {
+	Name      string
+	Namespace string
+	Data      map[string]string
+	Options   k8s_client.CreateOptions
+}
+
+func (req createConfigMapReq) validate() error {
+	if err := req.Options.Validate(); err != nil {
+		return err
+	}
+
+	return req.configMap().Validate()
+}
+
+func (req createConfigMapReq) configMap() k8s_client.ConfigMap {
+	return k8s_client.ConfigMap{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Data:      req.Data,
+	}
+}
+
+type getConfigMapReq struct {
+	Name      string
+	Namespace string
+}
+
+func (req getConfigMapReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type deleteConfigMapReq struct {
+	Name      string
+	Namespace string
+	Options   k8s_client.DeleteOptions
+}
+
+func (req deleteConfigMapReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.Options.Validate()
+}
+
+type createSecretReq struct {
+	Name      string
+	Namespace string
+	Type      string
+	Data      map[string]string
+	Options   k8s_client.CreateOptions
+}
+
+func (req createSecretReq) validate() error {
+	if err := req.Options.Validate(); err != nil {
+		return err
+	}
+
+	return req.secret().Validate()
+}
+
+func (req createSecretReq) secret() k8s_client.Secret {
+	return k8s_client.Secret{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Type:      req.Type,
+		Data:      req.Data,
+	}
+}
+
+type getSecretReq struct {
+	Name      string
+	Namespace string
+}
+
+func (req getSecretReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type deleteSecretReq struct {
+	Name      string
+	Namespace string
+	Options   k8s_client.DeleteOptions
+}
+
+func (req deleteSecretReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.Options.Validate()
+}
+
+type createProjectReq struct {
+	Name   string
+	Quota  k8s_client.ProjectQuota
+	Limits k8s_client.ProjectLimits
+}
+
+func (req createProjectReq) validate() error {
+	return req.project().Validate()
+}
+
+func (req createProjectReq) project() k8s_client.Project {
+	return k8s_client.Project{
+		Name:   req.Name,
+		Quota:  req.Quota,
+		Limits: req.Limits,
+	}
+}
+
+type getProjectReq struct {
+	Name string
+}
+
+func (req getProjectReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type deleteProjectReq struct {
+	Name    string
+	Options k8s_client.DeleteOptions
+}
+
+func (req deleteProjectReq) validate() error {
+	if req.Name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.Options.Validate()
+}
@@ -1,17 +1,936 @@
 package grpc
 
+import (
+	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/k8s-client"
+)
+
 type createPVRes struct {
-	name string
-	err error
+	name     string
+	manifest string
+	err      error
 }
 
 type createPVCRes struct {
-	name string
-	err error
+	name     string
+	manifest string
+	err      error
 }
 
 type createDeploymentRes struct {
-	name string
+	name     string
+	manifest string
+	err      error
+}
+
+type getPVRes struct {
+	pv  k8s_client.PersistentVolumeStatus
+	err error
+}
+
+type listPVsRes struct {
+	pvs []k8s_client.PersistentVolumeStatus
+	err error
+}
+
+type getPVCRes struct {
+	pvc k8s_client.PersistentVolumeClaimStatus
 	err error
 }
 
+type listPVCsRes struct {
+	pvcs []k8s_client.PersistentVolumeClaimStatus
+	err  error
+}
+
+type getDeploymentRes struct {
+	deployment k8s_client.DeploymentStatus
+	err        error
+}
+
+type listDeploymentsRes struct {
+	deployments []k8s_client.DeploymentStatus
+	err         error
+}
+
+type deleteRes struct {
+	name string
+	err  error
+}
+
+type createJobRes struct {
+	name     string
+	manifest string
+	err      error
+}
+
+type createRegistryCredentialRes struct {
+	name     string
+	manifest string
+	err      error
+}
+
+type exposeRes struct {
+	name string
+	err  error
+}
+
+type createConfigMapRes struct {
+	name     string
+	manifest string
+	err      error
+}
+
+type getConfigMapRes struct {
+	configMap k8s_client.ConfigMap
+	err       error
+}
+
+type createSecretRes struct {
+	name     string
+	manifest string
+	err      error
+}
+
+type getSecretRes struct {
+	secret k8s_client.SecretStatus
+	err    error
+}
+
+type createProjectRes struct {
+	name string
+	err  error
+}
+
+type getProjectRes struct {
+	project k8s_client.ProjectStatus
+	err     error
+}
+
+type listRegistryCredentialsRes struct {
+	creds []k8s_client.RegistryCredentialStatus
+	err   error
+}
+
+type nodeMetricsRes struct {
+	nodes []k8s_client.NodeMetrics
+	err   error
+}
+
+type podMetricsRes struct {
+	pods []k8s_client.PodMetrics
+	err  error
+}
+
+type clusterCapacityRes struct {
+	nodes []k8s_client.NodeCapacity
+	err   error
+}
+
+type canScheduleRes struct {
+	result k8s_client.ScheduleResult
+	err    error
+}
+
+func toInt32Value(v *int32) *quai.Int32Value {
+	if v == nil {
+		return nil
+	}
+	return &quai.Int32Value{Value: *v}
+}
+
+func fromInt32Value(v *quai.Int32Value) *int32 {
+	if v == nil {
+		return nil
+	}
+	value := v.Value
+	return &value
+}
+
+func toInt64Value(v *int64) *quai.Int64Value {
+	if v == nil {
+		return nil
+	}
+	return &quai.Int64Value{Value: *v}
+}
+
+func fromInt64Value(v *quai.Int64Value) *int64 {
+	if v == nil {
+		return nil
+	}
+	value := v.Value
+	return &value
+}
+
+func toDeleteOptionsMessage(opts k8s_client.DeleteOptions) *quai.DeleteOptions {
+	msg := &quai.DeleteOptions{PropagationPolicy: opts.PropagationPolicy}
+	if opts.GracePeriodSeconds != nil {
+		msg.GracePeriod = &quai.GracePeriod{Seconds: *opts.GracePeriodSeconds}
+	}
+	return msg
+}
+
+func fromDeleteOptionsMessage(msg *quai.DeleteOptions) k8s_client.DeleteOptions {
+	opts := k8s_client.DeleteOptions{PropagationPolicy: msg.GetPropagationPolicy()}
+	if gracePeriod := msg.GetGracePeriod(); gracePeriod != nil {
+		seconds := gracePeriod.Seconds
+		opts.GracePeriodSeconds = &seconds
+	}
+	return opts
+}
+
+func toCreateOptionsMessage(opts k8s_client.CreateOptions) *quai.CreateOptions {
+	return &quai.CreateOptions{Apply: opts.Apply, DryRun: opts.DryRun, Output: opts.Output}
+}
+
+func fromCreateOptionsMessage(msg *quai.CreateOptions) k8s_client.CreateOptions {
+	return k8s_client.CreateOptions{
+		Apply:  msg.GetApply(),
+		DryRun: msg.GetDryRun(),
+		Output: msg.GetOutput(),
+	}
+}
+
+func toPVMessage(pv k8s_client.PersistentVolumeStatus) *quai.PersistentVolume {
+	return &quai.PersistentVolume{
+		Name:           pv.Name,
+		Storage:        pv.Storage,
+		Phase:          pv.Phase,
+		Reason:         pv.Reason,
+		ClaimNamespace: pv.ClaimNamespace,
+		ClaimName:      pv.ClaimName,
+	}
+}
+
+func fromPVMessage(pv *quai.PersistentVolume) k8s_client.PersistentVolumeStatus {
+	return k8s_client.PersistentVolumeStatus{
+		Name:           pv.GetName(),
+		Storage:        pv.GetStorage(),
+		Phase:          pv.GetPhase(),
+		Reason:         pv.GetReason(),
+		ClaimNamespace: pv.GetClaimNamespace(),
+		ClaimName:      pv.GetClaimName(),
+	}
+}
+
+func toPVCMessage(pvc k8s_client.PersistentVolumeClaimStatus) *quai.PersistentVolumeClaim {
+	return &quai.PersistentVolumeClaim{
+		Name:       pvc.Name,
+		Namespace:  pvc.Namespace,
+		Storage:    pvc.Storage,
+		Phase:      pvc.Phase,
+		VolumeName: pvc.VolumeName,
+		Capacity:   pvc.Capacity,
+	}
+}
+
+func fromPVCMessage(pvc *quai.PersistentVolumeClaim) k8s_client.PersistentVolumeClaimStatus {
+	return k8s_client.PersistentVolumeClaimStatus{
+		Name:       pvc.GetName(),
+		Namespace:  pvc.GetNamespace(),
+		Storage:    pvc.GetStorage(),
+		Phase:      pvc.GetPhase(),
+		VolumeName: pvc.GetVolumeName(),
+		Capacity:   pvc.GetCapacity(),
+	}
+}
+
+func toDeploymentMessage(d k8s_client.DeploymentStatus) *quai.Deployment {
+	return &quai.Deployment{
+		Name:                d.Name,
+		Namespace:           d.Namespace,
+		Image:               d.Image,
+		Replicas:            d.Replicas,
+		UpdatedReplicas:     d.UpdatedReplicas,
+		ReadyReplicas:       d.ReadyReplicas,
+		AvailableReplicas:   d.AvailableReplicas,
+		UnavailableReplicas: d.UnavailableReplicas,
+	}
+}
+
+func fromDeploymentMessage(d *quai.Deployment) k8s_client.DeploymentStatus {
+	return k8s_client.DeploymentStatus{
+		Name:                d.GetName(),
+		Namespace:           d.GetNamespace(),
+		Image:               d.GetImage(),
+		Replicas:            d.GetReplicas(),
+		UpdatedReplicas:     d.GetUpdatedReplicas(),
+		ReadyReplicas:       d.GetReadyReplicas(),
+		AvailableReplicas:   d.GetAvailableReplicas(),
+		UnavailableReplicas: d.GetUnavailableReplicas(),
+	}
+}
+
+func toWorkloadEventMessage(e k8s_client.WorkloadEvent) *quai.WorkloadEvent {
+	var containers []*quai.ContainerState
+	for _, c := range e.Containers {
+		containers = append(containers, &quai.ContainerState{
+			Name:     c.Name,
+			State:    c.State,
+			Reason:   c.Reason,
+			Message:  c.Message,
+			ExitCode: c.ExitCode,
+		})
+	}
+
+	return &quai.WorkloadEvent{
+		Type:              e.Type,
+		Kind:              e.Kind,
+		Name:              e.Name,
+		Namespace:         e.Namespace,
+		Replicas:          e.Replicas,
+		ReadyReplicas:     e.ReadyReplicas,
+		AvailableReplicas: e.AvailableReplicas,
+		Active:            e.Active,
+		Succeeded:         e.Succeeded,
+		Failed:            e.Failed,
+		Phase:             e.Phase,
+		Containers:        containers,
+	}
+}
+
+func toLogLineMessage(l k8s_client.LogLine) *quai.LogLine {
+	return &quai.LogLine{
+		Pod:       l.Pod,
+		Container: l.Container,
+		Timestamp: l.Timestamp,
+		Message:   l.Message,
+	}
+}
+
+func toNodeMetricsMessage(m k8s_client.NodeMetrics) *quai.NodeMetrics {
+	return &quai.NodeMetrics{
+		Name:      m.Name,
+		CPU:       m.CPU,
+		Memory:    m.Memory,
+		Timestamp: m.Timestamp,
+	}
+}
+
+func fromNodeMetricsMessage(m *quai.NodeMetrics) k8s_client.NodeMetrics {
+	return k8s_client.NodeMetrics{
+		Name:      m.GetName(),
+		CPU:       m.GetCPU(),
+		Memory:    m.GetMemory(),
+		Timestamp: m.GetTimestamp(),
+	}
+}
+
+func toPodMetricsMessage(m k8s_client.PodMetrics) *quai.PodMetrics {
+	var containers []*quai.ContainerMetrics
+	for _, c := range m.Containers {
+		containers = append(containers, &quai.ContainerMetrics{
+			Name:   c.Name,
+			CPU:    c.CPU,
+			Memory: c.Memory,
+		})
+	}
+
+	return &quai.PodMetrics{
+		Name:       m.Name,
+		Namespace:  m.Namespace,
+		CPU:        m.CPU,
+		Memory:     m.Memory,
+		Timestamp:  m.Timestamp,
+		Containers: containers,
+	}
+}
+
+func fromPodMetricsMessage(m *quai.PodMetrics) k8s_client.PodMetrics {
+	var containers []k8s_client.ContainerMetrics
+	for _, c := range m.GetContainers() {
+		containers = append(containers, k8s_client.ContainerMetrics{
+			Name:   c.GetName(),
+			CPU:    c.GetCPU(),
+			Memory: c.GetMemory(),
+		})
+	}
+
+	return k8s_client.PodMetrics{
+		Name:       m.GetName(),
+		Namespace:  m.GetNamespace(),
+		CPU:        m.GetCPU(),
+		Memory:     m.GetMemory(),
+		Timestamp:  m.GetTimestamp(),
+		Containers: containers,
+	}
+}
+
+func toResourceAmountsMessage(a k8s_client.ResourceAmounts) *quai.ResourceAmounts {
+	return &quai.ResourceAmounts{
+		CPU:    a.CPU,
+		Memory: a.Memory,
+		GPU:    a.GPU,
+	}
+}
+
+func fromResourceAmountsMessage(a *quai.ResourceAmounts) k8s_client.ResourceAmounts {
+	return k8s_client.ResourceAmounts{
+		CPU:    a.GetCPU(),
+		Memory: a.GetMemory(),
+		GPU:    a.GetGPU(),
+	}
+}
+
+func toNodeCapacityMessage(n k8s_client.NodeCapacity) *quai.NodeCapacity {
+	return &quai.NodeCapacity{
+		Name:        n.Name,
+		GPUModel:    n.GPUModel,
+		Schedulable: n.Schedulable,
+		Allocatable: toResourceAmountsMessage(n.Allocatable),
+		Requested:   toResourceAmountsMessage(n.Requested),
+	}
+}
+
+func fromNodeCapacityMessage(n *quai.NodeCapacity) k8s_client.NodeCapacity {
+	return k8s_client.NodeCapacity{
+		Name:        n.GetName(),
+		GPUModel:    n.GetGPUModel(),
+		Schedulable: n.GetSchedulable(),
+		Allocatable: fromResourceAmountsMessage(n.GetAllocatable()),
+		Requested:   fromResourceAmountsMessage(n.GetRequested()),
+	}
+}
+
+func toScheduleResultMessage(r k8s_client.ScheduleResult) *quai.ScheduleResult {
+	return &quai.ScheduleResult{
+		Schedulable: r.Schedulable,
+		Nodes:       r.Nodes,
+		Reason:      r.Reason,
+	}
+}
+
+func fromScheduleResultMessage(r *quai.ScheduleResult) k8s_client.ScheduleResult {
+	return k8s_client.ScheduleResult{
+		Schedulable: r.GetSchedulable(),
+		Nodes:       r.GetNodes(),
+		Reason:      r.GetReason(),
+	}
+}
+
+func toVolumeSourceMessage(s k8s_client.VolumeSource) *quai.VolumeSource {
+	source := &quai.VolumeSource{}
+
+	if s.NFS != nil {
+		source.NFS = &quai.NFSVolumeSource{
+			Server:   s.NFS.Server,
+			Path:     s.NFS.Path,
+			ReadOnly: s.NFS.ReadOnly,
+		}
+	}
+
+	if s.HostPath != nil {
+		source.HostPath = &quai.HostPathVolumeSource{
+			Path: s.HostPath.Path,
+			Type: s.HostPath.Type,
+		}
+	}
+
+	if s.CephFS != nil {
+		source.CephFS = &quai.CephFSVolumeSource{
+			Monitors:        s.CephFS.Monitors,
+			Path:            s.CephFS.Path,
+			User:            s.CephFS.User,
+			SecretName:      s.CephFS.SecretName,
+			SecretNamespace: s.CephFS.SecretNamespace,
+			ReadOnly:        s.CephFS.ReadOnly,
+		}
+	}
+
+	if s.ISCSI != nil {
+		source.ISCSI = &quai.ISCSIVolumeSource{
+			TargetPortal: s.ISCSI.TargetPortal,
+			Portals:      s.ISCSI.Portals,
+			IQN:          s.ISCSI.IQN,
+			Lun:          s.ISCSI.Lun,
+			FSType:       s.ISCSI.FSType,
+			ReadOnly:     s.ISCSI.ReadOnly,
+		}
+	}
+
+	if s.Local != nil {
+		source.Local = &quai.LocalVolumeSource{
+			Path:   s.Local.Path,
+			FSType: s.Local.FSType,
+			Nodes:  s.Local.Nodes,
+		}
+	}
+
+	if s.CSI != nil {
+		source.CSI = &quai.CSIVolumeSource{
+			Driver:       s.CSI.Driver,
+			VolumeHandle: s.CSI.VolumeHandle,
+			FSType:       s.CSI.FSType,
+			ReadOnly:     s.CSI.ReadOnly,
+			Attributes:   s.CSI.Attributes,
+		}
+	}
+
+	return source
+}
+
+func fromVolumeSourceMessage(s *quai.VolumeSource) k8s_client.VolumeSource {
+	source := k8s_client.VolumeSource{}
+
+	if nfs := s.GetNFS(); nfs != nil {
+		source.NFS = &k8s_client.NFSVolumeSource{
+			Server:   nfs.GetServer(),
+			Path:     nfs.GetPath(),
+			ReadOnly: nfs.GetReadOnly(),
+		}
+	}
+
+	if hostPath := s.GetHostPath(); hostPath != nil {
+		source.HostPath = &k8s_client.HostPathVolumeSource{
+			Path: hostPath.GetPath(),
+			Type: hostPath.GetType(),
+		}
+	}
+
+	if cephFS := s.GetCephFS(); cephFS != nil {
+		source.CephFS = &k8s_client.CephFSVolumeSource{
+			Monitors:        cephFS.GetMonitors(),
+			Path:            cephFS.GetPath(),
+			User:            cephFS.GetUser(),
+			SecretName:      cephFS.GetSecretName(),
+			SecretNamespace: cephFS.GetSecretNamespace(),
+			ReadOnly:        cephFS.GetReadOnly(),
+		}
+	}
+
+	if iscsi := s.GetISCSI(); iscsi != nil {
+		source.ISCSI = &k8s_client.ISCSIVolumeSource{
+			TargetPortal: iscsi.GetTargetPortal(),
+			Portals:      iscsi.GetPortals(),
+			IQN:          iscsi.GetIQN(),
+			Lun:          iscsi.GetLun(),
+			FSType:       iscsi.GetFSType(),
+			ReadOnly:     iscsi.GetReadOnly(),
+		}
+	}
+
+	if local := s.GetLocal(); local != nil {
+		source.Local = &k8s_client.LocalVolumeSource{
+			Path:   local.GetPath(),
+			FSType: local.GetFSType(),
+			Nodes:  local.GetNodes(),
+		}
+	}
+
+	if csi := s.GetCSI(); csi != nil {
+		source.CSI = &k8s_client.CSIVolumeSource{
+			Driver:       csi.GetDriver(),
+			VolumeHandle: csi.GetVolumeHandle(),
+			FSType:       csi.GetFSType(),
+			ReadOnly:     csi.GetReadOnly(),
+			Attributes:   csi.GetAttributes(),
+		}
+	}
+
+	return source
+}
+
+func toKeySelectorMessage(s *k8s_client.KeySelector) *quai.KeySelector {
+	if s == nil {
+		return nil
+	}
+
+	return &quai.KeySelector{Name: s.Name, Key: s.Key, Optional: s.Optional}
+}
+
+func fromKeySelectorMessage(s *quai.KeySelector) *k8s_client.KeySelector {
+	if s == nil {
+		return nil
+	}
+
+	return &k8s_client.KeySelector{Name: s.GetName(), Key: s.GetKey(), Optional: s.GetOptional()}
+}
+
+func toEnvMessages(env []*k8s_client.EnvVar) []*quai.EnvVar {
+	var messages []*quai.EnvVar
+	for _, e := range env {
+		messages = append(messages, &quai.EnvVar{
+			Name:            e.Name,
+			Value:           e.Value,
+			SecretKeyRef:    toKeySelectorMessage(e.SecretKeyRef),
+			ConfigMapKeyRef: toKeySelectorMessage(e.ConfigMapKeyRef),
+		})
+	}
+
+	return messages
+}
+
+func fromEnvMessages(messages []*quai.EnvVar) []*k8s_client.EnvVar {
+	var env []*k8s_client.EnvVar
+	for _, e := range messages {
+		env = append(env, &k8s_client.EnvVar{
+			Name:            e.GetName(),
+			Value:           e.GetValue(),
+			SecretKeyRef:    fromKeySelectorMessage(e.GetSecretKeyRef()),
+			ConfigMapKeyRef: fromKeySelectorMessage(e.GetConfigMapKeyRef()),
+		})
+	}
+
+	return env
+}
+
+func toEnvFromMessages(sources []*k8s_client.EnvFromSource) []*quai.EnvFromSource {
+	var messages []*quai.EnvFromSource
+	for _, s := range sources {
+		messages = append(messages, &quai.EnvFromSource{
+			Prefix:        s.Prefix,
+			SecretName:    s.SecretName,
+			ConfigMapName: s.ConfigMapName,
+			Optional:      s.Optional,
+		})
+	}
+
+	return messages
+}
+
+func fromEnvFromMessages(messages []*quai.EnvFromSource) []*k8s_client.EnvFromSource {
+	var sources []*k8s_client.EnvFromSource
+	for _, s := range messages {
+		sources = append(sources, &k8s_client.EnvFromSource{
+			Prefix:        s.GetPrefix(),
+			SecretName:    s.GetSecretName(),
+			ConfigMapName: s.GetConfigMapName(),
+			Optional:      s.GetOptional(),
+		})
+	}
+
+	return sources
+}
+
+func toTolerationMessages(tolerations []*k8s_client.Toleration) []*quai.Toleration {
+	var messages []*quai.Toleration
+	for _, t := range tolerations {
+		messages = append(messages, &quai.Toleration{
+			Key:               t.Key,
+			Operator:          t.Operator,
+			Value:             t.Value,
+			Effect:            t.Effect,
+			TolerationSeconds: toInt64Value(t.TolerationSeconds),
+		})
+	}
+
+	return messages
+}
+
+func fromTolerationMessages(messages []*quai.Toleration) []*k8s_client.Toleration {
+	var tolerations []*k8s_client.Toleration
+	for _, t := range messages {
+		tolerations = append(tolerations, &k8s_client.Toleration{
+			Key:               t.GetKey(),
+			Operator:          t.GetOperator(),
+			Value:             t.GetValue(),
+			Effect:            t.GetEffect(),
+			TolerationSeconds: fromInt64Value(t.GetTolerationSeconds()),
+		})
+	}
+
+	return tolerations
+}
+
+func toLabelRequirementMessages(requirements []*k8s_client.LabelRequirement) []*quai.LabelRequirement {
+	var messages []*quai.LabelRequirement
+	for _, r := range requirements {
+		messages = append(messages, &quai.LabelRequirement{Key: r.Key, Operator: r.Operator, Values: r.Values})
+	}
+
+	return messages
+}
+
+func fromLabelRequirementMessages(messages []*quai.LabelRequirement) []*k8s_client.LabelRequirement {
+	var requirements []*k8s_client.LabelRequirement
+	for _, r := range messages {
+		requirements = append(requirements, &k8s_client.LabelRequirement{Key: r.GetKey(), Operator: r.GetOperator(), Values: r.GetValues()})
+	}
+
+	return requirements
+}
+
+func toPodAffinityTermMessages(terms []*k8s_client.PodAffinityTerm) []*quai.PodAffinityTerm {
+	var messages []*quai.PodAffinityTerm
+	for _, t := range terms {
+		messages = append(messages, &quai.PodAffinityTerm{
+			Weight:           t.Weight,
+			MatchLabels:      t.MatchLabels,
+			MatchExpressions: toLabelRequirementMessages(t.MatchExpressions),
+			Namespaces:       t.Namespaces,
+			TopologyKey:      t.TopologyKey,
+		})
+	}
+
+	return messages
+}
+
+func fromPodAffinityTermMessages(messages []*quai.PodAffinityTerm) []*k8s_client.PodAffinityTerm {
+	var terms []*k8s_client.PodAffinityTerm
+	for _, t := range messages {
+		terms = append(terms, &k8s_client.PodAffinityTerm{
+			Weight:           t.GetWeight(),
+			MatchLabels:      t.GetMatchLabels(),
+			MatchExpressions: fromLabelRequirementMessages(t.GetMatchExpressions()),
+			Namespaces:       t.GetNamespaces(),
+			TopologyKey:      t.GetTopologyKey(),
+		})
+	}
+
+	return terms
+}
+
+func toAffinityMessage(a *k8s_client.Affinity) *quai.Affinity {
+	if a == nil {
+		return nil
+	}
+
+	message := &quai.Affinity{
+		PodAffinity:     toPodAffinityTermMessages(a.PodAffinity),
+		PodAntiAffinity: toPodAffinityTermMessages(a.PodAntiAffinity),
+	}
+	for _, t := range a.NodeAffinity {
+		message.NodeAffinity = append(message.NodeAffinity, &quai.NodeSelectorTerm{
+			Weight:           t.Weight,
+			MatchExpressions: toLabelRequirementMessages(t.MatchExpressions),
+		})
+	}
+
+	return message
+}
+
+func fromAffinityMessage(message *quai.Affinity) *k8s_client.Affinity {
+	if message == nil {
+		return nil
+	}
+
+	a := &k8s_client.Affinity{
+		PodAffinity:     fromPodAffinityTermMessages(message.GetPodAffinity()),
+		PodAntiAffinity: fromPodAffinityTermMessages(message.GetPodAntiAffinity()),
+	}
+	for _, t := range message.GetNodeAffinity() {
+		a.NodeAffinity = append(a.NodeAffinity, &k8s_client.NodeSelectorTerm{
+			Weight:           t.GetWeight(),
+			MatchExpressions: fromLabelRequirementMessages(t.GetMatchExpressions()),
+		})
+	}
+
+	return a
+}
+
+func toResourceQuantitiesMessage(q *k8s_client.ResourceQuantities) *quai.ResourceQuantities {
+	if q == nil {
+		return nil
+	}
+
+	return &quai.ResourceQuantities{
+		CPU:              q.CPU,
+		Memory:           q.Memory,
+		GPU:              q.GPU,
+		EphemeralStorage: q.EphemeralStorage,
+		HugePages:        q.HugePages,
+	}
+}
+
+func fromResourceQuantitiesMessage(message *quai.ResourceQuantities) *k8s_client.ResourceQuantities {
+	if message == nil {
+		return nil
+	}
+
+	return &k8s_client.ResourceQuantities{
+		CPU:              message.GetCPU(),
+		Memory:           message.GetMemory(),
+		GPU:              message.GetGPU(),
+		EphemeralStorage: message.GetEphemeralStorage(),
+		HugePages:        message.GetHugePages(),
+	}
+}
+
+func toRegistryCredentialMessage(cred k8s_client.RegistryCredentialStatus) *quai.RegistryCredential {
+	return &quai.RegistryCredential{
+		Name:      cred.Name,
+		Namespace: cred.Namespace,
+		Servers:   cred.Servers,
+		Username:  cred.Username,
+	}
+}
+
+func fromRegistryCredentialMessage(message *quai.RegistryCredential) k8s_client.RegistryCredentialStatus {
+	return k8s_client.RegistryCredentialStatus{
+		Name:      message.GetName(),
+		Namespace: message.GetNamespace(),
+		Servers:   message.GetServers(),
+		Username:  message.GetUsername(),
+	}
+}
+
+func toContainerPortMessages(ports []*k8s_client.ContainerPort) []*quai.ContainerPort {
+	var messages []*quai.ContainerPort
+	for _, p := range ports {
+		messages = append(messages, &quai.ContainerPort{Name: p.Name, ContainerPort: p.ContainerPort, Protocol: p.Protocol})
+	}
+
+	return messages
+}
+
+func fromContainerPortMessages(messages []*quai.ContainerPort) []*k8s_client.ContainerPort {
+	var ports []*k8s_client.ContainerPort
+	for _, p := range messages {
+		ports = append(ports, &k8s_client.ContainerPort{Name: p.GetName(), ContainerPort: p.GetContainerPort(), Protocol: p.GetProtocol()})
+	}
+
+	return ports
+}
+
+func toServicePortMessages(ports []*k8s_client.ServicePort) []*quai.ServicePort {
+	var messages []*quai.ServicePort
+	for _, p := range ports {
+		messages = append(messages, &quai.ServicePort{
+			Name:       p.Name,
+			Port:       p.Port,
+			TargetPort: p.TargetPort,
+			NodePort:   p.NodePort,
+			Protocol:   p.Protocol,
+		})
+	}
+
+	return messages
+}
+
+func fromServicePortMessages(messages []*quai.ServicePort) []*k8s_client.ServicePort {
+	var ports []*k8s_client.ServicePort
+	for _, p := range messages {
+		ports = append(ports, &k8s_client.ServicePort{
+			Name:       p.GetName(),
+			Port:       p.GetPort(),
+			TargetPort: p.GetTargetPort(),
+			NodePort:   p.GetNodePort(),
+			Protocol:   p.GetProtocol(),
+		})
+	}
+
+	return ports
+}
+
+func toIngressRuleMessage(rule *k8s_client.IngressRule) *quai.IngressRule {
+	if rule == nil {
+		return nil
+	}
+
+	return &quai.IngressRule{
+		Host:          rule.Host,
+		Path:          rule.Path,
+		ServicePort:   rule.ServicePort,
+		TLSSecretName: rule.TLSSecretName,
+	}
+}
+
+func fromIngressRuleMessage(message *quai.IngressRule) *k8s_client.IngressRule {
+	if message == nil {
+		return nil
+	}
+
+	return &k8s_client.IngressRule{
+		Host:          message.GetHost(),
+		Path:          message.GetPath(),
+		ServicePort:   message.GetServicePort(),
+		TLSSecretName: message.GetTLSSecretName(),
+	}
+}
+
+func toConfigMapMessage(cm k8s_client.ConfigMap) *quai.ConfigMap {
+	return &quai.ConfigMap{Name: cm.Name, Namespace: cm.Namespace, Data: cm.Data}
+}
+
+func fromConfigMapMessage(message *quai.ConfigMap) k8s_client.ConfigMap {
+	data := message.GetData()
+	if data == nil {
+		data = map[string]string{}
+	}
+
+	return k8s_client.ConfigMap{Name: message.GetName(), Namespace: message.GetNamespace(), Data: data}
+}
+
+func toSecretMessage(secret k8s_client.SecretStatus) *quai.Secret {
+	return &quai.Secret{
+		Name:      secret.Name,
+		Namespace: secret.Namespace,
+		Type:      secret.Type,
+		Keys:      secret.Keys,
+	}
+}
+
+func fromSecretMessage(message *quai.Secret) k8s_client.SecretStatus {
+	keys := message.GetKeys()
+	if keys == nil {
+		keys = []string{}
+	}
+
+	return k8s_client.SecretStatus{
+		Name:      message.GetName(),
+		Namespace: message.GetNamespace(),
+		Type:      message.GetType(),
+		Keys:      keys,
+	}
+}
+
+func toProjectQuotaMessage(q k8s_client.ProjectQuota) *quai.ProjectQuota {
+	return &quai.ProjectQuota{
+		CPU:     q.CPU,
+		Memory:  q.Memory,
+		GPU:     q.GPU,
+		PVCs:    q.PVCs,
+		Storage: q.Storage,
+	}
+}
+
+func fromProjectQuotaMessage(message *quai.ProjectQuota) k8s_client.ProjectQuota {
+	return k8s_client.ProjectQuota{
+		CPU:     message.GetCPU(),
+		Memory:  message.GetMemory(),
+		GPU:     message.GetGPU(),
+		PVCs:    message.GetPVCs(),
+		Storage: message.GetStorage(),
+	}
+}
+
+func toProjectLimitsMessage(l k8s_client.ProjectLimits) *quai.ProjectLimits {
+	return &quai.ProjectLimits{
+		Default:        toResourceQuantitiesMessage(&l.Default),
+		DefaultRequest: toResourceQuantitiesMessage(&l.DefaultRequest),
+	}
+}
+
+func fromProjectLimitsMessage(message *quai.ProjectLimits) k8s_client.ProjectLimits {
+	var l k8s_client.ProjectLimits
+	if q := fromResourceQuantitiesMessage(message.GetDefault()); q != nil {
+		l.Default = *q
+	}
+	if q := fromResourceQuantitiesMessage(message.GetDefaultRequest()); q != nil {
+		l.DefaultRequest = *q
+	}
+
+	return l
+}
+
+func toProjectMessage(project k8s_client.ProjectStatus) *quai.Project {
+	return &quai.Project{
+		Name:           project.Name,
+		Phase:          project.Phase,
+		Quota:          toProjectQuotaMessage(project.Quota),
+		Used:           toProjectQuotaMessage(project.Used),
+		Limits:         toProjectLimitsMessage(project.Limits),
+		ServiceAccount: project.ServiceAccount,
+	}
+}
+
+func fromProjectMessage(message *quai.Project) k8s_client.ProjectStatus {
+	return k8s_client.ProjectStatus{
+		Name:           message.GetName(),
+		Phase:          message.GetPhase(),
+		Quota:          fromProjectQuotaMessage(message.GetQuota()),
+		Used:           fromProjectQuotaMessage(message.GetUsed()),
+		Limits:         fromProjectLimitsMessage(message.GetLimits()),
+		ServiceAccount: message.GetServiceAccount(),
+	}
+}
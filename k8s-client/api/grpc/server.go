@@ -10,28 +10,67 @@ package grpc
 import (
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/errors"
 	"github.com/hykuan/k8s-client-example/k8s-client"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 var _ quai.K8SClientServiceServer = (*grpcServer)(nil)
 
 type grpcServer struct {
+	svc                         k8s_client.Service
 	createNFSPersistentVolume   kitgrpc.Handler
+	createPersistentVolume      kitgrpc.Handler
 	createPersistentVolumeClaim kitgrpc.Handler
 	createDeployment            kitgrpc.Handler
+	getPersistentVolume         kitgrpc.Handler
+	listPersistentVolumes       kitgrpc.Handler
+	getPersistentVolumeClaim    kitgrpc.Handler
+	listPersistentVolumeClaims  kitgrpc.Handler
+	getDeployment               kitgrpc.Handler
+	listDeployments             kitgrpc.Handler
+	deletePersistentVolume      kitgrpc.Handler
+	deletePersistentVolumeClaim kitgrpc.Handler
+	deleteDeployment            kitgrpc.Handler
+	updateDeployment            kitgrpc.Handler
+	scaleDeployment             kitgrpc.Handler
+	createJob                   kitgrpc.Handler
+	getNodeMetrics              kitgrpc.Handler
+	getPodMetrics               kitgrpc.Handler
+	getClusterCapacity          kitgrpc.Handler
+	canSchedule                 kitgrpc.Handler
+	createRegistryCredential    kitgrpc.Handler
+	listRegistryCredentials     kitgrpc.Handler
+	deleteRegistryCredential    kitgrpc.Handler
+	exposeDeployment            kitgrpc.Handler
+	createConfigMap             kitgrpc.Handler
+	getConfigMap                kitgrpc.Handler
+	updateConfigMap             kitgrpc.Handler
+	deleteConfigMap             kitgrpc.Handler
+	createSecret                kitgrpc.Handler
+	getSecret                   kitgrpc.Handler
+	updateSecret                kitgrpc.Handler
+	deleteSecret                kitgrpc.Handler
+	createProject               kitgrpc.Handler
+	getProject                  kitgrpc.Handler
+	updateProject               kitgrpc.Handler
+	deleteProject               kitgrpc.Handler
 }
 
 // NewServer returns new K8sClientServiceServer instance.
 func NewServer(svc k8s_client.Service) quai.K8SClientServiceServer {
 	return &grpcServer{
+		svc: svc,
 		createNFSPersistentVolume: kitgrpc.NewServer(
 			createNFSPVEndpoint(svc),
 			decodeCreateNFSPVCRequest,
 			encodeCreateNFSPVCResponse,
 		),
+		createPersistentVolume: kitgrpc.NewServer(
+			createPVEndpoint(svc),
+			decodeCreatePVRequest,
+			encodeCreateNFSPVCResponse,
+		),
 		createPersistentVolumeClaim: kitgrpc.NewServer(
 			createPVCEndpoint(svc),
 			decodeCreatePVCRequest,
@@ -42,6 +81,166 @@ func NewServer(svc k8s_client.Service) quai.K8SClientServiceServer {
 			decodeCreateDeploymentRequest,
 			encodeCreateDeploymentResponse,
 		),
+		getPersistentVolume: kitgrpc.NewServer(
+			getPVEndpoint(svc),
+			decodeGetPVRequest,
+			encodeGetPVResponse,
+		),
+		listPersistentVolumes: kitgrpc.NewServer(
+			listPVsEndpoint(svc),
+			decodeListPVsRequest,
+			encodeListPVsResponse,
+		),
+		getPersistentVolumeClaim: kitgrpc.NewServer(
+			getPVCEndpoint(svc),
+			decodeGetPVCRequest,
+			encodeGetPVCResponse,
+		),
+		listPersistentVolumeClaims: kitgrpc.NewServer(
+			listPVCsEndpoint(svc),
+			decodeListPVCsRequest,
+			encodeListPVCsResponse,
+		),
+		getDeployment: kitgrpc.NewServer(
+			getDeploymentEndpoint(svc),
+			decodeGetDeploymentRequest,
+			encodeGetDeploymentResponse,
+		),
+		listDeployments: kitgrpc.NewServer(
+			listDeploymentsEndpoint(svc),
+			decodeListDeploymentsRequest,
+			encodeListDeploymentsResponse,
+		),
+		deletePersistentVolume: kitgrpc.NewServer(
+			deletePVEndpoint(svc),
+			decodeDeletePVRequest,
+			encodeDeletePVResponse,
+		),
+		deletePersistentVolumeClaim: kitgrpc.NewServer(
+			deletePVCEndpoint(svc),
+			decodeDeletePVCRequest,
+			encodeDeletePVCResponse,
+		),
+		deleteDeployment: kitgrpc.NewServer(
+			deleteDeploymentEndpoint(svc),
+			decodeDeleteDeploymentRequest,
+			encodeDeleteDeploymentResponse,
+		),
+		updateDeployment: kitgrpc.NewServer(
+			updateDeploymentEndpoint(svc),
+			decodeUpdateDeploymentRequest,
+			encodeCreateDeploymentResponse,
+		),
+		scaleDeployment: kitgrpc.NewServer(
+			scaleDeploymentEndpoint(svc),
+			decodeScaleDeploymentRequest,
+			encodeCreateDeploymentResponse,
+		),
+		createJob: kitgrpc.NewServer(
+			createJobEndpoint(svc),
+			decodeCreateJobRequest,
+			encodeCreateJobResponse,
+		),
+		getNodeMetrics: kitgrpc.NewServer(
+			getNodeMetricsEndpoint(svc),
+			decodeGetNodeMetricsRequest,
+			encodeGetNodeMetricsResponse,
+		),
+		getPodMetrics: kitgrpc.NewServer(
+			getPodMetricsEndpoint(svc),
+			decodeGetPodMetricsRequest,
+			encodeGetPodMetricsResponse,
+		),
+		getClusterCapacity: kitgrpc.NewServer(
+			getClusterCapacityEndpoint(svc),
+			decodeGetClusterCapacityRequest,
+			encodeGetClusterCapacityResponse,
+		),
+		canSchedule: kitgrpc.NewServer(
+			canScheduleEndpoint(svc),
+			decodeCreateDeploymentRequest,
+			encodeCanScheduleResponse,
+		),
+		createRegistryCredential: kitgrpc.NewServer(
+			createRegistryCredentialEndpoint(svc),
+			decodeCreateRegistryCredentialRequest,
+			encodeCreateRegistryCredentialResponse,
+		),
+		listRegistryCredentials: kitgrpc.NewServer(
+			listRegistryCredentialsEndpoint(svc),
+			decodeListRegistryCredentialsRequest,
+			encodeListRegistryCredentialsResponse,
+		),
+		deleteRegistryCredential: kitgrpc.NewServer(
+			deleteRegistryCredentialEndpoint(svc),
+			decodeDeleteRegistryCredentialRequest,
+			encodeDeleteRegistryCredentialResponse,
+		),
+		exposeDeployment: kitgrpc.NewServer(
+			exposeDeploymentEndpoint(svc),
+			decodeExposeRequest,
+			encodeExposeResponse,
+		),
+		createConfigMap: kitgrpc.NewServer(
+			createConfigMapEndpoint(svc),
+			decodeCreateConfigMapRequest,
+			encodeCreateConfigMapResponse,
+		),
+		getConfigMap: kitgrpc.NewServer(
+			getConfigMapEndpoint(svc),
+			decodeGetConfigMapRequest,
+			encodeGetConfigMapResponse,
+		),
+		updateConfigMap: kitgrpc.NewServer(
+			updateConfigMapEndpoint(svc),
+			decodeCreateConfigMapRequest,
+			encodeCreateConfigMapResponse,
+		),
+		deleteConfigMap: kitgrpc.NewServer(
+			deleteConfigMapEndpoint(svc),
+			decodeDeleteConfigMapRequest,
+			encodeDeleteConfigMapResponse,
+		),
+		createSecret: kitgrpc.NewServer(
+			createSecretEndpoint(svc),
+			decodeCreateSecretRequest,
+			encodeCreateSecretResponse,
+		),
+		getSecret: kitgrpc.NewServer(
+			getSecretEndpoint(svc),
+			decodeGetSecretRequest,
+			encodeGetSecretResponse,
+		),
+		updateSecret: kitgrpc.NewServer(
+			updateSecretEndpoint(svc),
+			decodeCreateSecretRequest,
+			encodeCreateSecretResponse,
+		),
+		deleteSecret: kitgrpc.NewServer(
+			deleteSecretEndpoint(svc),
+			decodeDeleteSecretRequest,
+			encodeDeleteSecretResponse,
+		),
+		createProject: kitgrpc.NewServer(
+			createProjectEndpoint(svc),
+			decodeCreateProjectRequest,
+			encodeCreateProjectResponse,
+		),
+		getProject: kitgrpc.NewServer(
+			getProjectEndpoint(svc),
+			decodeGetProjectRequest,
+			encodeGetProjectResponse,
+		),
+		updateProject: kitgrpc.NewServer(
+			updateProjectEndpoint(svc),
+			decodeCreateProjectRequest,
+			encodeCreateProjectResponse,
+		),
+		deleteProject: kitgrpc.NewServer(
+			deleteProjectEndpoint(svc),
+			decodeDeleteProjectRequest,
+			encodeDeleteProjectResponse,
+		),
 	}
 }
 
@@ -53,6 +252,14 @@ func (s *grpcServer) CreateNFSPersistentVolume(ctx context.Context, req *quai.NF
 	return res.(*quai.PersistentVolumeName), nil
 }
 
+func (s *grpcServer) CreatePersistentVolume(ctx context.Context, req *quai.PersistentVolumeReq) (*quai.PersistentVolumeName, error) {
+	_, res, err := s.createPersistentVolume.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.PersistentVolumeName), nil
+}
+
 func (s *grpcServer) CreatePersistentVolumeClaim(ctx context.Context, req *quai.PersistentVolumeClaimReq) (*quai.PersistentVolumeClaimName, error) {
 	_, res, err := s.createPersistentVolumeClaim.ServeGRPC(ctx, req)
 	if err != nil {
@@ -69,32 +276,314 @@ func (s *grpcServer) CreateDeployment(ctx context.Context, req *quai.DeploymentR
 	return res.(*quai.DeploymentName), nil
 }
 
+func (s *grpcServer) GetPersistentVolume(ctx context.Context, req *quai.GetPersistentVolumeReq) (*quai.PersistentVolume, error) {
+	_, res, err := s.getPersistentVolume.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.PersistentVolume), nil
+}
+
+func (s *grpcServer) ListPersistentVolumes(ctx context.Context, req *quai.ListPersistentVolumesReq) (*quai.PersistentVolumeList, error) {
+	_, res, err := s.listPersistentVolumes.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.PersistentVolumeList), nil
+}
+
+func (s *grpcServer) GetPersistentVolumeClaim(ctx context.Context, req *quai.GetPersistentVolumeClaimReq) (*quai.PersistentVolumeClaim, error) {
+	_, res, err := s.getPersistentVolumeClaim.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.PersistentVolumeClaim), nil
+}
+
+func (s *grpcServer) ListPersistentVolumeClaims(ctx context.Context, req *quai.ListPersistentVolumeClaimsReq) (*quai.PersistentVolumeClaimList, error) {
+	_, res, err := s.listPersistentVolumeClaims.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.PersistentVolumeClaimList), nil
+}
+
+func (s *grpcServer) GetDeployment(ctx context.Context, req *quai.GetDeploymentReq) (*quai.Deployment, error) {
+	_, res, err := s.getDeployment.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.Deployment), nil
+}
+
+func (s *grpcServer) ListDeployments(ctx context.Context, req *quai.ListDeploymentsReq) (*quai.DeploymentList, error) {
+	_, res, err := s.listDeployments.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.DeploymentList), nil
+}
+
+func (s *grpcServer) DeletePersistentVolume(ctx context.Context, req *quai.DeletePersistentVolumeReq) (*quai.PersistentVolumeName, error) {
+	_, res, err := s.deletePersistentVolume.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.PersistentVolumeName), nil
+}
+
+func (s *grpcServer) DeletePersistentVolumeClaim(ctx context.Context, req *quai.DeletePersistentVolumeClaimReq) (*quai.PersistentVolumeClaimName, error) {
+	_, res, err := s.deletePersistentVolumeClaim.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.PersistentVolumeClaimName), nil
+}
+
+func (s *grpcServer) DeleteDeployment(ctx context.Context, req *quai.DeleteDeploymentReq) (*quai.DeploymentName, error) {
+	_, res, err := s.deleteDeployment.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.DeploymentName), nil
+}
+
+func (s *grpcServer) UpdateDeployment(ctx context.Context, req *quai.DeploymentReq) (*quai.DeploymentName, error) {
+	_, res, err := s.updateDeployment.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.DeploymentName), nil
+}
+
+func (s *grpcServer) ScaleDeployment(ctx context.Context, req *quai.ScaleDeploymentReq) (*quai.DeploymentName, error) {
+	_, res, err := s.scaleDeployment.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.DeploymentName), nil
+}
+
+func (s *grpcServer) CreateJob(ctx context.Context, req *quai.JobReq) (*quai.JobName, error) {
+	_, res, err := s.createJob.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.JobName), nil
+}
+
+func (s *grpcServer) GetNodeMetrics(ctx context.Context, req *quai.NodeMetricsReq) (*quai.NodeMetricsList, error) {
+	_, res, err := s.getNodeMetrics.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.NodeMetricsList), nil
+}
+
+func (s *grpcServer) GetPodMetrics(ctx context.Context, req *quai.PodMetricsReq) (*quai.PodMetricsList, error) {
+	_, res, err := s.getPodMetrics.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.PodMetricsList), nil
+}
+
+func (s *grpcServer) GetClusterCapacity(ctx context.Context, req *quai.ClusterCapacityReq) (*quai.ClusterCapacity, error) {
+	_, res, err := s.getClusterCapacity.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ClusterCapacity), nil
+}
+
+func (s *grpcServer) CanSchedule(ctx context.Context, req *quai.DeploymentReq) (*quai.ScheduleResult, error) {
+	_, res, err := s.canSchedule.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ScheduleResult), nil
+}
+
+func (s *grpcServer) CreateRegistryCredential(ctx context.Context, req *quai.RegistryCredentialReq) (*quai.RegistryCredentialName, error) {
+	_, res, err := s.createRegistryCredential.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.RegistryCredentialName), nil
+}
+
+func (s *grpcServer) ListRegistryCredentials(ctx context.Context, req *quai.ListRegistryCredentialsReq) (*quai.RegistryCredentialList, error) {
+	_, res, err := s.listRegistryCredentials.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.RegistryCredentialList), nil
+}
+
+func (s *grpcServer) DeleteRegistryCredential(ctx context.Context, req *quai.DeleteRegistryCredentialReq) (*quai.RegistryCredentialName, error) {
+	_, res, err := s.deleteRegistryCredential.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.RegistryCredentialName), nil
+}
+
+func (s *grpcServer) ExposeDeployment(ctx context.Context, req *quai.ExposeReq) (*quai.ServiceName, error) {
+	_, res, err := s.exposeDeployment.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ServiceName), nil
+}
+
+func (s *grpcServer) CreateConfigMap(ctx context.Context, req *quai.ConfigMapReq) (*quai.ConfigMapName, error) {
+	_, res, err := s.createConfigMap.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ConfigMapName), nil
+}
+
+func (s *grpcServer) GetConfigMap(ctx context.Context, req *quai.GetConfigMapReq) (*quai.ConfigMap, error) {
+	_, res, err := s.getConfigMap.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ConfigMap), nil
+}
+
+func (s *grpcServer) UpdateConfigMap(ctx context.Context, req *quai.ConfigMapReq) (*quai.ConfigMapName, error) {
+	_, res, err := s.updateConfigMap.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ConfigMapName), nil
+}
+
+func (s *grpcServer) DeleteConfigMap(ctx context.Context, req *quai.DeleteConfigMapReq) (*quai.ConfigMapName, error) {
+	_, res, err := s.deleteConfigMap.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ConfigMapName), nil
+}
+
+func (s *grpcServer) CreateSecret(ctx context.Context, req *quai.SecretReq) (*quai.SecretName, error) {
+	_, res, err := s.createSecret.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.SecretName), nil
+}
+
+func (s *grpcServer) GetSecret(ctx context.Context, req *quai.GetSecretReq) (*quai.Secret, error) {
+	_, res, err := s.getSecret.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.Secret), nil
+}
+
+func (s *grpcServer) UpdateSecret(ctx context.Context, req *quai.SecretReq) (*quai.SecretName, error) {
+	_, res, err := s.updateSecret.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.SecretName), nil
+}
+
+func (s *grpcServer) DeleteSecret(ctx context.Context, req *quai.DeleteSecretReq) (*quai.SecretName, error) {
+	_, res, err := s.deleteSecret.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.SecretName), nil
+}
+
+func (s *grpcServer) CreateProject(ctx context.Context, req *quai.ProjectReq) (*quai.ProjectName, error) {
+	_, res, err := s.createProject.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ProjectName), nil
+}
+
+func (s *grpcServer) GetProject(ctx context.Context, req *quai.GetProjectReq) (*quai.Project, error) {
+	_, res, err := s.getProject.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.Project), nil
+}
+
+func (s *grpcServer) UpdateProject(ctx context.Context, req *quai.ProjectReq) (*quai.ProjectName, error) {
+	_, res, err := s.updateProject.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ProjectName), nil
+}
+
+func (s *grpcServer) DeleteProject(ctx context.Context, req *quai.DeleteProjectReq) (*quai.ProjectName, error) {
+	_, res, err := s.deleteProject.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*quai.ProjectName), nil
+}
+
 func decodeCreateNFSPVCRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*quai.NFSPersistentVolumeReq)
 	return createNFSPVReq{
-		Name:    req.Name,
-		Storage: req.Storage,
-		Server:  req.Server,
-		Path:    req.Path,
+		Name:             req.Name,
+		Storage:          req.Storage,
+		Server:           req.Server,
+		Path:             req.Path,
+		AccessModes:      req.AccessModes,
+		StorageClassName: req.StorageClassName,
+		Labels:           req.Labels,
+		Options:          fromCreateOptionsMessage(req.Options),
 	}, nil
 }
 
 func encodeCreateNFSPVCResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(createPVRes)
-	return &quai.PersistentVolumeName{Value: res.name}, encodeError(res.err)
+	return &quai.PersistentVolumeName{Value: res.name, Manifest: res.manifest}, encodeError(res.err)
+}
+
+func decodeCreatePVRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.PersistentVolumeReq)
+	return createPVReq{
+		PersistentVolume: k8s_client.PersistentVolume{
+			Name:             req.GetName(),
+			Storage:          req.GetStorage(),
+			AccessModes:      req.GetAccessModes(),
+			StorageClassName: req.GetStorageClassName(),
+			Labels:           req.GetLabels(),
+			Source:           fromVolumeSourceMessage(req.GetSource()),
+		},
+		Options: fromCreateOptionsMessage(req.GetOptions()),
+	}, nil
 }
 
 func decodeCreatePVCRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*quai.PersistentVolumeClaimReq)
 	return createPVCReq{
-		Name:    req.Name,
-		Storage: req.Storage,
+		Name:             req.Name,
+		Namespace:        req.Namespace,
+		Storage:          req.Storage,
+		StorageClassName: req.StorageClassName,
+		AccessModes:      req.AccessModes,
+		VolumeMode:       req.VolumeMode,
+		VolumeName:       req.VolumeName,
+		Selector:         req.Selector,
+		Options:          fromCreateOptionsMessage(req.Options),
 	}, nil
 }
 
 func encodeCreatePVCResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(createPVCRes)
-	return &quai.PersistentVolumeClaimName{Value: res.name}, encodeError(res.err)
+	return &quai.PersistentVolumeClaimName{Value: res.name, Manifest: res.manifest}, encodeError(res.err)
 }
 
 func decodeCreateDeploymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
@@ -105,43 +594,541 @@ func decodeCreateDeploymentRequest(_ context.Context, grpcReq interface{}) (inte
 		resource.Memory = req.Resource.Memory
 		resource.CPU = req.Resource.CPU
 		resource.GPU = req.Resource.GPU
+		resource.Requests = fromResourceQuantitiesMessage(req.Resource.Requests)
+		resource.Limits = fromResourceQuantitiesMessage(req.Resource.Limits)
 	}
 	volumes := []*VolumeInfo{}
 	for _, volume := range req.Volumes {
 		volumes = append(volumes, &VolumeInfo{
-			Name:      volume.Name,
-			PVCName:   volume.PVCName,
-			MountPath: volume.MountPath,
+			Name:          volume.Name,
+			PVCName:       volume.PVCName,
+			SecretName:    volume.SecretName,
+			ConfigMapName: volume.ConfigMapName,
+			MountPath:     volume.MountPath,
+			ReadOnly:      volume.ReadOnly,
 		})
 	}
 
 	return createDeploymentReq{
-			Name:      req.Name,
-			Replicas:  req.Replicas,
-			Image:     req.Image,
-			Resource:  &resource,
-			Volumes:   volumes,
-			Command:   req.Command,
-			Arguments: req.Arguments,
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Replicas:  req.Replicas,
+		Image:     req.Image,
+		Resource:  &resource,
+		Volumes:   volumes,
+		Command:   req.Command,
+		Arguments: req.Arguments,
+		Env:       fromEnvMessages(req.Env),
+		EnvFrom:   fromEnvFromMessages(req.EnvFrom),
+		Ports:     fromContainerPortMessages(req.Ports),
+
+		ImagePullSecrets: req.ImagePullSecrets,
+
+		NodeSelector:      req.NodeSelector,
+		Tolerations:       fromTolerationMessages(req.Tolerations),
+		Affinity:          fromAffinityMessage(req.Affinity),
+		PriorityClassName: req.PriorityClassName,
+		SchedulerName:     req.SchedulerName,
+
+		Options: fromCreateOptionsMessage(req.Options),
 	}, nil
 }
 
 func encodeCreateDeploymentResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(createDeploymentRes)
+	return &quai.DeploymentName{Value: res.name, Manifest: res.manifest}, encodeError(res.err)
+}
+
+func decodeGetPVRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.GetPersistentVolumeReq)
+	return getPVReq{Name: req.Name}, nil
+}
+
+func encodeGetPVResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(getPVRes)
+	return toPVMessage(res.pv), encodeError(res.err)
+}
+
+func decodeListPVsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return listPVsReq{}, nil
+}
+
+func encodeListPVsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(listPVsRes)
+	list := &quai.PersistentVolumeList{}
+	for _, pv := range res.pvs {
+		list.Items = append(list.Items, toPVMessage(pv))
+	}
+	return list, encodeError(res.err)
+}
+
+func decodeGetPVCRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.GetPersistentVolumeClaimReq)
+	return getPVCReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func encodeGetPVCResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(getPVCRes)
+	return toPVCMessage(res.pvc), encodeError(res.err)
+}
+
+func decodeListPVCsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.ListPersistentVolumeClaimsReq)
+	return listPVCsReq{Namespace: req.Namespace}, nil
+}
+
+func encodeListPVCsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(listPVCsRes)
+	list := &quai.PersistentVolumeClaimList{}
+	for _, pvc := range res.pvcs {
+		list.Items = append(list.Items, toPVCMessage(pvc))
+	}
+	return list, encodeError(res.err)
+}
+
+func decodeGetDeploymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.GetDeploymentReq)
+	return getDeploymentReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func encodeGetDeploymentResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(getDeploymentRes)
+	return toDeploymentMessage(res.deployment), encodeError(res.err)
+}
+
+func decodeListDeploymentsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.ListDeploymentsReq)
+	return listDeploymentsReq{Namespace: req.Namespace}, nil
+}
+
+func encodeListDeploymentsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(listDeploymentsRes)
+	list := &quai.DeploymentList{}
+	for _, deployment := range res.deployments {
+		list.Items = append(list.Items, toDeploymentMessage(deployment))
+	}
+	return list, encodeError(res.err)
+}
+
+func decodeDeletePVRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.DeletePersistentVolumeReq)
+	return deletePVReq{
+		Name:    req.Name,
+		Options: fromDeleteOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeDeletePVResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(deleteRes)
+	return &quai.PersistentVolumeName{Value: res.name}, encodeError(res.err)
+}
+
+func decodeDeletePVCRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.DeletePersistentVolumeClaimReq)
+	return deletePVCReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Options:   fromDeleteOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeDeletePVCResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(deleteRes)
+	return &quai.PersistentVolumeClaimName{Value: res.name}, encodeError(res.err)
+}
+
+func decodeDeleteDeploymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.DeleteDeploymentReq)
+	return deleteDeploymentReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Options:   fromDeleteOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeDeleteDeploymentResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(deleteRes)
 	return &quai.DeploymentName{Value: res.name}, encodeError(res.err)
 }
 
-func encodeError(err error) error {
-	if err == nil {
-		return nil
+func decodeUpdateDeploymentRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req, err := decodeCreateDeploymentRequest(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return updateDeploymentReq(req.(createDeploymentReq)), nil
+}
+
+func decodeScaleDeploymentRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.ScaleDeploymentReq)
+	return scaleDeploymentReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Replicas:  req.Replicas,
+	}, nil
+}
+
+func decodeCreateJobRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.JobReq)
+
+	resource := Resource{}
+	if req.Resource != nil {
+		resource.Memory = req.Resource.Memory
+		resource.CPU = req.Resource.CPU
+		resource.GPU = req.Resource.GPU
+		resource.Requests = fromResourceQuantitiesMessage(req.Resource.Requests)
+		resource.Limits = fromResourceQuantitiesMessage(req.Resource.Limits)
 	}
+	volumes := []*VolumeInfo{}
+	for _, volume := range req.Volumes {
+		volumes = append(volumes, &VolumeInfo{
+			Name:          volume.Name,
+			PVCName:       volume.PVCName,
+			SecretName:    volume.SecretName,
+			ConfigMapName: volume.ConfigMapName,
+			MountPath:     volume.MountPath,
+			ReadOnly:      volume.ReadOnly,
+		})
+	}
+
+	return createJobReq{
+		Name:                    req.Name,
+		Namespace:               req.Namespace,
+		Image:                   req.Image,
+		Resource:                &resource,
+		Volumes:                 volumes,
+		Command:                 req.Command,
+		Arguments:               req.Arguments,
+		Env:                     fromEnvMessages(req.Env),
+		EnvFrom:                 fromEnvFromMessages(req.EnvFrom),
+		BackoffLimit:            fromInt32Value(req.BackoffLimit),
+		ActiveDeadlineSeconds:   fromInt64Value(req.ActiveDeadlineSeconds),
+		Completions:             fromInt32Value(req.Completions),
+		Parallelism:             fromInt32Value(req.Parallelism),
+		TTLSecondsAfterFinished: fromInt32Value(req.TTLSecondsAfterFinished),
+
+		ImagePullSecrets: req.ImagePullSecrets,
 
-	switch err {
-	case k8s_client.ErrMalformedEntity:
-		return status.Error(codes.InvalidArgument, "received invalid token request")
-	case k8s_client.ErrUnauthorizedAccess:
-		return status.Error(codes.Unauthenticated, "failed to identify user from token")
-	default:
-		return status.Error(codes.Internal, "internal server error")
+		NodeSelector:      req.NodeSelector,
+		Tolerations:       fromTolerationMessages(req.Tolerations),
+		Affinity:          fromAffinityMessage(req.Affinity),
+		PriorityClassName: req.PriorityClassName,
+		SchedulerName:     req.SchedulerName,
+
+		Options: fromCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeCreateJobResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(createJobRes)
+	return &quai.JobName{Value: res.name, Manifest: res.manifest}, encodeError(res.err)
+}
+
+func decodeGetNodeMetricsRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return nodeMetricsReq{}, nil
+}
+
+func encodeGetNodeMetricsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(nodeMetricsRes)
+	list := &quai.NodeMetricsList{}
+	for _, node := range res.nodes {
+		list.Items = append(list.Items, toNodeMetricsMessage(node))
+	}
+	return list, encodeError(res.err)
+}
+
+func decodeGetPodMetricsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.PodMetricsReq)
+	return podMetricsReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func encodeGetPodMetricsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(podMetricsRes)
+	list := &quai.PodMetricsList{}
+	for _, pod := range res.pods {
+		list.Items = append(list.Items, toPodMetricsMessage(pod))
 	}
+	return list, encodeError(res.err)
+}
+
+func decodeGetClusterCapacityRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return clusterCapacityReq{}, nil
+}
+
+func encodeGetClusterCapacityResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(clusterCapacityRes)
+	capacity := &quai.ClusterCapacity{}
+	for _, node := range res.nodes {
+		capacity.Nodes = append(capacity.Nodes, toNodeCapacityMessage(node))
+	}
+	return capacity, encodeError(res.err)
+}
+
+func encodeCanScheduleResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(canScheduleRes)
+	return toScheduleResultMessage(res.result), encodeError(res.err)
+}
+
+func decodeCreateRegistryCredentialRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.RegistryCredentialReq)
+	return createRegistryCredentialReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Server:    req.Server,
+		Username:  req.Username,
+		Token:     req.Token,
+		Options:   fromCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeCreateRegistryCredentialResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(createRegistryCredentialRes)
+	return &quai.RegistryCredentialName{Value: res.name, Manifest: res.manifest}, encodeError(res.err)
+}
+
+func decodeListRegistryCredentialsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.ListRegistryCredentialsReq)
+	return listRegistryCredentialsReq{Namespace: req.Namespace}, nil
+}
+
+func encodeListRegistryCredentialsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(listRegistryCredentialsRes)
+	list := &quai.RegistryCredentialList{}
+	for _, cred := range res.creds {
+		list.Items = append(list.Items, toRegistryCredentialMessage(cred))
+	}
+	return list, encodeError(res.err)
+}
+
+func decodeDeleteRegistryCredentialRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.DeleteRegistryCredentialReq)
+	return deleteRegistryCredentialReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Options:   fromDeleteOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeDeleteRegistryCredentialResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(deleteRes)
+	return &quai.RegistryCredentialName{Value: res.name}, encodeError(res.err)
+}
+
+func decodeExposeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.ExposeReq)
+	return exposeReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Type:      req.Type,
+		Ports:     fromServicePortMessages(req.Ports),
+		Ingress:   fromIngressRuleMessage(req.Ingress),
+	}, nil
+}
+
+func encodeExposeResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(exposeRes)
+	return &quai.ServiceName{Value: res.name}, encodeError(res.err)
+}
+
+func decodeCreateConfigMapRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.ConfigMapReq)
+	return createConfigMapReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Data:      req.Data,
+		Options:   fromCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeCreateConfigMapResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(createConfigMapRes)
+	return &quai.ConfigMapName{Value: res.name, Manifest: res.manifest}, encodeError(res.err)
+}
+
+func decodeGetConfigMapRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.GetConfigMapReq)
+	return getConfigMapReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func encodeGetConfigMapResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(getConfigMapRes)
+	return toConfigMapMessage(res.configMap), encodeError(res.err)
+}
+
+func decodeDeleteConfigMapRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.DeleteConfigMapReq)
+	return deleteConfigMapReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Options:   fromDeleteOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeDeleteConfigMapResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(deleteRes)
+	return &quai.ConfigMapName{Value: res.name}, encodeError(res.err)
+}
+
+func decodeCreateSecretRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.SecretReq)
+	return createSecretReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Type:      req.Type,
+		Data:      req.Data,
+		Options:   fromCreateOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeCreateSecretResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(createSecretRes)
+	return &quai.SecretName{Value: res.name, Manifest: res.manifest}, encodeError(res.err)
+}
+
+func decodeGetSecretRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.GetSecretReq)
+	return getSecretReq{Name: req.Name, Namespace: req.Namespace}, nil
+}
+
+func encodeGetSecretResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(getSecretRes)
+	return toSecretMessage(res.secret), encodeError(res.err)
+}
+
+func decodeDeleteSecretRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.DeleteSecretReq)
+	return deleteSecretReq{
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Options:   fromDeleteOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeDeleteSecretResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(deleteRes)
+	return &quai.SecretName{Value: res.name}, encodeError(res.err)
+}
+
+func decodeCreateProjectRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.ProjectReq)
+	return createProjectReq{
+		Name:   req.Name,
+		Quota:  fromProjectQuotaMessage(req.Quota),
+		Limits: fromProjectLimitsMessage(req.Limits),
+	}, nil
+}
+
+func encodeCreateProjectResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(createProjectRes)
+	return &quai.ProjectName{Value: res.name}, encodeError(res.err)
+}
+
+func decodeGetProjectRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.GetProjectReq)
+	return getProjectReq{Name: req.Name}, nil
+}
+
+func encodeGetProjectResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(getProjectRes)
+	return toProjectMessage(res.project), encodeError(res.err)
+}
+
+func decodeDeleteProjectRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*quai.DeleteProjectReq)
+	return deleteProjectReq{
+		Name:    req.Name,
+		Options: fromDeleteOptionsMessage(req.Options),
+	}, nil
+}
+
+func encodeDeleteProjectResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(deleteRes)
+	return &quai.ProjectName{Value: res.name}, encodeError(res.err)
+}
+
+// WatchDeployment streams status transitions of a deployment and its pods. A
+// watch that fails closes the stream with its error.
+// Streaming RPCs aren't supported by go-kit, so the service is called directly.
+func (s *grpcServer) WatchDeployment(req *quai.WatchReq, stream quai.K8SClientService_WatchDeploymentServer) error {
+	r := watchReq{Name: req.GetName(), Namespace: req.GetNamespace()}
+	if err := r.validate(); err != nil {
+		return encodeError(err)
+	}
+
+	events, err := s.svc.WatchDeployment(stream.Context(), r.Namespace, r.Name)
+	if err != nil {
+		return encodeError(err)
+	}
+
+	for e := range events {
+		if e.Err != nil {
+			return encodeError(e.Err)
+		}
+		if err := stream.Send(toWorkloadEventMessage(e)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WatchJob streams status transitions of a job and its pods.
+func (s *grpcServer) WatchJob(req *quai.WatchReq, stream quai.K8SClientService_WatchJobServer) error {
+	r := watchReq{Name: req.GetName(), Namespace: req.GetNamespace()}
+	if err := r.validate(); err != nil {
+		return encodeError(err)
+	}
+
+	events, err := s.svc.WatchJob(stream.Context(), r.Namespace, r.Name)
+	if err != nil {
+		return encodeError(err)
+	}
+
+	for e := range events {
+		if e.Err != nil {
+			return encodeError(e.Err)
+		}
+		if err := stream.Send(toWorkloadEventMessage(e)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StreamLogs streams the timestamped logs of the pods behind a workload.
+// A pod log that fails to be read to the end closes the stream with its error.
+func (s *grpcServer) StreamLogs(req *quai.LogsReq, stream quai.K8SClientService_StreamLogsServer) error {
+	r := logsReq{
+		Name:      req.GetName(),
+		Namespace: req.GetNamespace(),
+		Options: k8s_client.LogOptions{
+			Container: req.GetContainer(),
+			Follow:    req.GetFollow(),
+			TailLines: fromInt64Value(req.GetTailLines()),
+		},
+	}
+	if err := r.validate(); err != nil {
+		return encodeError(err)
+	}
+
+	lines, err := s.svc.StreamLogs(stream.Context(), r.Namespace, r.Name, r.Options)
+	if err != nil {
+		return encodeError(err)
+	}
+
+	for l := range lines {
+		if l.Err != nil {
+			return encodeError(l.Err)
+		}
+		if err := stream.Send(toLogLineMessage(l)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeError(err error) error {
+	return errors.ToStatus(err)
 }
@@ -0,0 +1,224 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/k8s-client"
+	grpcapi "github.com/hykuan/k8s-client-example/k8s-client/api/grpc"
+	"github.com/hykuan/k8s-client-example/k8s-client/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	apiv1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	namespace = "training"
+	name      = "mnist"
+)
+
+func TestDeleteErrorCodes(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+
+	cases := map[string]struct {
+		err  error
+		code codes.Code
+	}{
+		"delete non-existing deployment": {
+			err:  k8sErrors.NewNotFound(deployments, name),
+			code: codes.NotFound,
+		},
+		"delete deployment without permission": {
+			err:  k8sErrors.NewForbidden(deployments, name, fmt.Errorf("service account can't delete deployments")),
+			code: codes.PermissionDenied,
+		},
+		"delete deployment on unavailable cluster": {
+			err:  k8sErrors.NewServiceUnavailable("etcd leader changed"),
+			code: codes.Unavailable,
+		},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace)
+		h.ClientSet.PrependReactor("delete", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, tc.err
+		})
+
+		server := grpcapi.NewServer(h.Service)
+		_, err := server.DeleteDeployment(context.Background(), &quai.DeleteDeploymentReq{Name: name, Namespace: namespace})
+		assert.Equal(t, tc.code, status.Code(err), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, status.Code(err)))
+	}
+
+	server := grpcapi.NewServer(mocks.NewHarness(namespace).Service)
+	_, err := server.DeletePersistentVolumeClaim(context.Background(), &quai.DeletePersistentVolumeClaimReq{Name: "unknown", Namespace: namespace})
+	assert.Equal(t, codes.NotFound, status.Code(err), fmt.Sprintf("delete unknown pvc: expected %s got %s", codes.NotFound, status.Code(err)))
+}
+
+// watchStream collects the events a watch RPC sends, calling next after
+// each of them.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	next   func(n int)
+	events []*quai.WorkloadEvent
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(e *quai.WorkloadEvent) error {
+	s.events = append(s.events, e)
+	s.next(len(s.events))
+	return nil
+}
+
+// watchHarness serves deployment and job at resource version 10, and sends
+// their watch events through the returned fake watcher.
+func watchHarness(objects ...runtime.Object) (mocks.Harness, *watch.FakeWatcher) {
+	h := mocks.NewHarness(namespace, objects...)
+	h.ClientSet.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &apiv1.PodList{ListMeta: metav1.ListMeta{ResourceVersion: "10"}}, nil
+	})
+
+	watcher := watch.NewFake()
+	h.ClientSet.PrependWatchReactor("deployments", k8stesting.DefaultWatchReactor(watcher, nil))
+	h.ClientSet.PrependWatchReactor("jobs", k8stesting.DefaultWatchReactor(watcher, nil))
+
+	return h, watcher
+}
+
+func TestWatchDeployment(t *testing.T) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: "10"}}
+	h, watcher := watchHarness(deployment)
+	server := grpcapi.NewServer(h.Service)
+
+	err := server.WatchDeployment(&quai.WatchReq{Name: "unknown"}, &watchStream{ctx: context.Background()})
+	assert.Equal(t, codes.NotFound, status.Code(err), fmt.Sprintf("watch unknown deployment: expected %s got %s", codes.NotFound, status.Code(err)))
+
+	ready := deployment.DeepCopy()
+	ready.ResourceVersion, ready.Status.ReadyReplicas = "11", 1
+
+	stream := &watchStream{ctx: context.Background()}
+	stream.next = func(n int) {
+		switch n {
+		case 1:
+			go watcher.Modify(ready)
+		case 2:
+			go watcher.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusGone, Reason: metav1.StatusReasonExpired, Message: "too old resource version"})
+		}
+	}
+
+	err = server.WatchDeployment(&quai.WatchReq{Name: name, Namespace: namespace}, stream)
+	assert.Equal(t, codes.Unavailable, status.Code(err), fmt.Sprintf("expected %s got %s", codes.Unavailable, status.Code(err)))
+
+	require.Len(t, stream.events, 2, "wrong number of events")
+	assert.Equal(t, "ADDED", stream.events[0].Type, "wrong initial event type")
+	assert.Equal(t, "MODIFIED", stream.events[1].Type, "wrong event type")
+	assert.Equal(t, int32(1), stream.events[1].ReadyReplicas, "wrong ready replicas")
+}
+
+func TestWatchJob(t *testing.T) {
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: "10"}}
+	h, _ := watchHarness(job)
+	server := grpcapi.NewServer(h.Service)
+
+	// The caller goes away after the first event, which must end the RPC.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchStream{ctx: ctx, next: func(int) { cancel() }}
+
+	err := server.WatchJob(&quai.WatchReq{Name: name, Namespace: namespace}, stream)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	require.Len(t, stream.events, 1, "wrong number of events")
+	assert.Equal(t, k8s_client.KindJob, stream.events[0].Kind, "wrong event kind")
+}
+
+// logsStream collects the lines a StreamLogs RPC sends.
+type logsStream struct {
+	grpc.ServerStream
+	lines []*quai.LogLine
+}
+
+func (s *logsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *logsStream) Send(l *quai.LogLine) error {
+	s.lines = append(s.lines, l)
+	return nil
+}
+
+func TestStreamLogs(t *testing.T) {
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "mnist-0", Namespace: namespace, Labels: map[string]string{"app": name}},
+		Spec:       apiv1.PodSpec{Containers: []apiv1.Container{{Name: name}}},
+	}
+	h := mocks.NewHarness(namespace, pod)
+	server := grpcapi.NewServer(h.Service)
+
+	cases := map[string]struct {
+		req   *quai.LogsReq
+		log   io.Reader
+		code  codes.Code
+		lines []string
+	}{
+		"stream logs of unknown workload": {
+			req:  &quai.LogsReq{Name: "unknown", Namespace: namespace},
+			code: codes.NotFound,
+		},
+		"stream logs without name": {
+			req:  &quai.LogsReq{Namespace: namespace},
+			code: codes.InvalidArgument,
+		},
+		"stream logs": {
+			req:   &quai.LogsReq{Name: name, Namespace: namespace},
+			log:   strings.NewReader("2020-04-01T10:00:00Z epoch 1\n2020-04-01T10:00:01Z epoch 2\n"),
+			code:  codes.OK,
+			lines: []string{"epoch 1", "epoch 2"},
+		},
+		"stream logs cut off by the api server": {
+			req:   &quai.LogsReq{Name: name, Namespace: namespace},
+			log:   io.MultiReader(strings.NewReader("2020-04-01T10:00:00Z epoch 1\n"), brokenReader{}),
+			code:  codes.Unavailable,
+			lines: []string{"epoch 1"},
+		},
+	}
+
+	for desc, tc := range cases {
+		h.Logs["mnist-0"] = tc.log
+
+		stream := &logsStream{}
+		err := server.StreamLogs(tc.req, stream)
+		assert.Equal(t, tc.code, status.Code(err), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, status.Code(err)))
+
+		var lines []string
+		for _, l := range stream.lines {
+			assert.Equal(t, "mnist-0", l.Pod, fmt.Sprintf("%s: wrong pod", desc))
+			lines = append(lines, l.Message)
+		}
+		assert.Equal(t, tc.lines, lines, fmt.Sprintf("%s: wrong lines", desc))
+	}
+}
+
+// brokenReader fails like a log stream cut off by the API server.
+type brokenReader struct{}
+
+func (brokenReader) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("connection reset by peer")
+}
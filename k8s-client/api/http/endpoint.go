@@ -6,50 +6,766 @@ import (
 	"github.com/hykuan/k8s-client-example/k8s-client"
 )
 
-func createNFSPVEndpoint(svc k8s_client.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
-		req := request.(nfsPVReq)
+func createPVEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(pvReq)
 
 		if err := req.validate(); err != nil {
 			return nil, err
 		}
 
-		name, err := svc.CreateNFSPV(req.pv)
-		return PVRes{name}, err
+		res, err := svc.CreatePV(ctx, req.pv, req.opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.opts.DryRun != "" {
+			return ManifestRes{Manifest: res.Manifest, Output: req.opts.Output}, nil
+		}
+
+		return PVRes{res.Name}, nil
 	}
 }
 
 func createPVCEndpoint(svc k8s_client.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(pvcReq)
 
 		if err := req.validate(); err != nil {
 			return nil, err
 		}
 
-		name, err := svc.CreatePVC(req.pvc)
+		res, err := svc.CreatePVC(ctx, req.pvc, req.opts)
 		if err != nil {
 			return nil, err
 		}
 
-		return PVCRes{name}, nil
+		if req.opts.DryRun != "" {
+			return ManifestRes{Manifest: res.Manifest, Output: req.opts.Output}, nil
+		}
+
+		return PVCRes{res.Name}, nil
 	}
 }
 
 func createDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(deploymentReq)
 
 		if err := req.validate(); err != nil {
 			return nil, err
 		}
 
-		name, err := svc.CreateDeployment(req.deployment)
+		res, err := svc.CreateDeployment(ctx, req.deployment, req.opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.opts.DryRun != "" {
+			return ManifestRes{Manifest: res.Manifest, Output: req.opts.Output}, nil
+		}
+
+		return DeploymentRes{res.Name}, nil
+	}
+}
+
+func viewPVEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewPVReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pv, err := svc.GetPV(ctx, req.name)
+		if err != nil {
+			return nil, err
+		}
+
+		return toViewPVRes(pv), nil
+	}
+}
+
+func listPVsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listPVsReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pvs, err := svc.ListPVs(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		return DeploymentRes{name}, nil
+		res := ListPVsRes{PersistentVolumes: []ViewPVRes{}}
+		for _, pv := range pvs {
+			res.PersistentVolumes = append(res.PersistentVolumes, toViewPVRes(pv))
+		}
+
+		return res, nil
 	}
 }
 
+func viewPVCEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pvc, err := svc.GetPVC(ctx, req.namespace, req.name)
+		if err != nil {
+			return nil, err
+		}
+
+		return toViewPVCRes(pvc), nil
+	}
+}
+
+func listPVCsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listResourcesReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pvcs, err := svc.ListPVCs(ctx, req.namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		res := ListPVCsRes{PersistentVolumeClaims: []ViewPVCRes{}}
+		for _, pvc := range pvcs {
+			res.PersistentVolumeClaims = append(res.PersistentVolumeClaims, toViewPVCRes(pvc))
+		}
+
+		return res, nil
+	}
+}
+
+func viewDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		deployment, err := svc.GetDeployment(ctx, req.namespace, req.name)
+		if err != nil {
+			return nil, err
+		}
+
+		return toViewDeploymentRes(deployment), nil
+	}
+}
+
+func listDeploymentsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listResourcesReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		deployments, err := svc.ListDeployments(ctx, req.namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		res := ListDeploymentsRes{Deployments: []ViewDeploymentRes{}}
+		for _, deployment := range deployments {
+			res.Deployments = append(res.Deployments, toViewDeploymentRes(deployment))
+		}
+
+		return res, nil
+	}
+}
+
+func nodeMetricsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		nodes, err := svc.GetNodeMetrics(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		res := NodeMetricsRes{Nodes: []NodeUsageRes{}}
+		for _, node := range nodes {
+			res.Nodes = append(res.Nodes, NodeUsageRes{
+				Name:      node.Name,
+				CPU:       node.CPU,
+				Memory:    node.Memory,
+				Timestamp: node.Timestamp,
+			})
+		}
+
+		return res, nil
+	}
+}
+
+func podMetricsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pods, err := svc.GetPodMetrics(ctx, req.namespace, req.name)
+		if err != nil {
+			return nil, err
+		}
+
+		res := PodMetricsRes{Pods: []PodUsageRes{}}
+		for _, pod := range pods {
+			res.Pods = append(res.Pods, toPodUsageRes(pod))
+		}
+
+		return res, nil
+	}
+}
+
+func clusterCapacityEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		nodes, err := svc.ClusterCapacity(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		res := ClusterCapacityRes{Nodes: []NodeCapacityRes{}}
+		for _, node := range nodes {
+			res.Nodes = append(res.Nodes, NodeCapacityRes{
+				Name:        node.Name,
+				GPUModel:    node.GPUModel,
+				Schedulable: node.Schedulable,
+				Allocatable: toResourceAmountsRes(node.Allocatable),
+				Requested:   toResourceAmountsRes(node.Requested),
+			})
+		}
+
+		return res, nil
+	}
+}
+
+func canScheduleEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deploymentReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		result, err := svc.CanSchedule(ctx, req.deployment)
+		if err != nil {
+			return nil, err
+		}
+
+		return ScheduleRes{
+			Schedulable: result.Schedulable,
+			Nodes:       result.Nodes,
+			Reason:      result.Reason,
+		}, nil
+	}
+}
+
+func toResourceAmountsRes(a k8s_client.ResourceAmounts) ResourceAmountsRes {
+	return ResourceAmountsRes{
+		CPU:    a.CPU,
+		Memory: a.Memory,
+		GPU:    a.GPU,
+	}
+}
+
+func toPodUsageRes(pod k8s_client.PodMetrics) PodUsageRes {
+	res := PodUsageRes{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		CPU:       pod.CPU,
+		Memory:    pod.Memory,
+		Timestamp: pod.Timestamp,
+	}
+
+	for _, c := range pod.Containers {
+		res.Containers = append(res.Containers, ContainerUsageRes{
+			Name:   c.Name,
+			CPU:    c.CPU,
+			Memory: c.Memory,
+		})
+	}
+
+	return res
+}
+
+func toViewPVRes(pv k8s_client.PersistentVolumeStatus) ViewPVRes {
+	return ViewPVRes{
+		Name:           pv.Name,
+		Storage:        pv.Storage,
+		Phase:          pv.Phase,
+		Reason:         pv.Reason,
+		ClaimNamespace: pv.ClaimNamespace,
+		ClaimName:      pv.ClaimName,
+	}
+}
+
+func toViewPVCRes(pvc k8s_client.PersistentVolumeClaimStatus) ViewPVCRes {
+	return ViewPVCRes{
+		Name:       pvc.Name,
+		Namespace:  pvc.Namespace,
+		Storage:    pvc.Storage,
+		Phase:      pvc.Phase,
+		VolumeName: pvc.VolumeName,
+		Capacity:   pvc.Capacity,
+	}
+}
+
+func toViewDeploymentRes(deployment k8s_client.DeploymentStatus) ViewDeploymentRes {
+	return ViewDeploymentRes{
+		Name:                deployment.Name,
+		Namespace:           deployment.Namespace,
+		Image:               deployment.Image,
+		Replicas:            deployment.Replicas,
+		UpdatedReplicas:     deployment.UpdatedReplicas,
+		ReadyReplicas:       deployment.ReadyReplicas,
+		AvailableReplicas:   deployment.AvailableReplicas,
+		UnavailableReplicas: deployment.UnavailableReplicas,
+	}
+}
+
+func deletePVEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deletePVReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeletePV(ctx, req.name, req.opts); err != nil {
+			return nil, err
+		}
+
+		return DeleteRes{}, nil
+	}
+}
+
+func deletePVCEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeletePVC(ctx, req.namespace, req.name, req.opts); err != nil {
+			return nil, err
+		}
+
+		return DeleteRes{}, nil
+	}
+}
+
+func deleteDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteDeployment(ctx, req.namespace, req.name, req.opts); err != nil {
+			return nil, err
+		}
+
+		return DeleteRes{}, nil
+	}
+}
+
+func updateDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateDeploymentReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.UpdateDeployment(ctx, req.deployment)
+		if err != nil {
+			return nil, err
+		}
+
+		return UpdateDeploymentRes{name}, nil
+	}
+}
+
+func scaleDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(scaleDeploymentReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.ScaleDeployment(ctx, req.namespace, req.name, *req.Replicas); err != nil {
+			return nil, err
+		}
+
+		return ScaleDeploymentRes{Name: req.name, Replicas: *req.Replicas}, nil
+	}
+}
+
+func createJobEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(jobReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreateJob(ctx, req.job, req.opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.opts.DryRun != "" {
+			return ManifestRes{Manifest: res.Manifest, Output: req.opts.Output}, nil
+		}
+
+		return JobRes{res.Name}, nil
+	}
+}
+
+func createRegistryCredentialEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(registryCredentialReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreateRegistryCredential(ctx, req.cred, req.opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.opts.DryRun != "" {
+			return ManifestRes{Manifest: res.Manifest, Output: req.opts.Output}, nil
+		}
+
+		return RegistryCredentialRes{res.Name}, nil
+	}
+}
+
+func listRegistryCredentialsEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listResourcesReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		creds, err := svc.ListRegistryCredentials(ctx, req.namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		res := ListRegistryCredentialsRes{RegistryCredentials: []ViewRegistryCredentialRes{}}
+		for _, cred := range creds {
+			res.RegistryCredentials = append(res.RegistryCredentials, ViewRegistryCredentialRes{
+				Name:      cred.Name,
+				Namespace: cred.Namespace,
+				Servers:   cred.Servers,
+				Username:  cred.Username,
+			})
+		}
+
+		return res, nil
+	}
+}
+
+func deleteRegistryCredentialEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteRegistryCredential(ctx, req.namespace, req.name, req.opts); err != nil {
+			return nil, err
+		}
+
+		return DeleteRes{}, nil
+	}
+}
+
+func exposeDeploymentEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(exposeReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.ExposeDeployment(ctx, req.exposure)
+		if err != nil {
+			return nil, err
+		}
+
+		return ExposeRes{name}, nil
+	}
+}
+
+func createConfigMapEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(configMapReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreateConfigMap(ctx, req.configMap, req.opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.opts.DryRun != "" {
+			return ManifestRes{Manifest: res.Manifest, Output: req.opts.Output}, nil
+		}
+
+		return ConfigMapRes{res.Name}, nil
+	}
+}
+
+func viewConfigMapEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		cm, err := svc.GetConfigMap(ctx, req.namespace, req.name)
+		if err != nil {
+			return nil, err
+		}
+
+		return ViewConfigMapRes{
+			Name:      cm.Name,
+			Namespace: cm.Namespace,
+			Data:      cm.Data,
+		}, nil
+	}
+}
+
+func updateConfigMapEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(configMapReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.UpdateConfigMap(ctx, req.configMap)
+		if err != nil {
+			return nil, err
+		}
+
+		return UpdateConfigMapRes{name}, nil
+	}
+}
+
+func deleteConfigMapEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteConfigMap(ctx, req.namespace, req.name, req.opts); err != nil {
+			return nil, err
+		}
+
+		return DeleteRes{}, nil
+	}
+}
+
+func createSecretEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(secretReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		res, err := svc.CreateSecret(ctx, req.secret, req.opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.opts.DryRun != "" {
+			return ManifestRes{Manifest: res.Manifest, Output: req.opts.Output}, nil
+		}
+
+		return SecretRes{res.Name}, nil
+	}
+}
+
+func viewSecretEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		secret, err := svc.GetSecret(ctx, req.namespace, req.name)
+		if err != nil {
+			return nil, err
+		}
+
+		return ViewSecretRes{
+			Name:      secret.Name,
+			Namespace: secret.Namespace,
+			Type:      secret.Type,
+			Keys:      secret.Keys,
+		}, nil
+	}
+}
+
+func updateSecretEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(secretReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.UpdateSecret(ctx, req.secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return UpdateSecretRes{name}, nil
+	}
+}
+
+func deleteSecretEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteSecret(ctx, req.namespace, req.name, req.opts); err != nil {
+			return nil, err
+		}
+
+		return DeleteRes{}, nil
+	}
+}
+
+func createProjectEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(projectReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.CreateProject(ctx, req.project)
+		if err != nil {
+			return nil, err
+		}
+
+		return ProjectRes{name}, nil
+	}
+}
+
+func viewProjectEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		project, err := svc.GetProject(ctx, req.name)
+		if err != nil {
+			return nil, err
+		}
+
+		return ViewProjectRes{
+			Name:           project.Name,
+			Phase:          project.Phase,
+			Quota:          toProjectQuotaRes(project.Quota),
+			Used:           toProjectQuotaRes(project.Used),
+			Limits:         toProjectLimitsRes(project.Limits),
+			ServiceAccount: project.ServiceAccount,
+		}, nil
+	}
+}
+
+func updateProjectEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(projectReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		name, err := svc.UpdateProject(ctx, req.project)
+		if err != nil {
+			return nil, err
+		}
+
+		return UpdateProjectRes{name}, nil
+	}
+}
+
+func deleteProjectEndpoint(svc k8s_client.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deleteResourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.DeleteProject(ctx, req.name, req.opts); err != nil {
+			return nil, err
+		}
+
+		return DeleteRes{}, nil
+	}
+}
+
+func toProjectQuotaRes(q k8s_client.ProjectQuota) ProjectQuotaRes {
+	return ProjectQuotaRes{
+		CPU:     q.CPU,
+		Memory:  q.Memory,
+		GPU:     q.GPU,
+		PVCs:    q.PVCs,
+		Storage: q.Storage,
+	}
+}
+
+func toProjectLimitsRes(l k8s_client.ProjectLimits) ProjectLimitsRes {
+	return ProjectLimitsRes{
+		Default:        toResourceQuantitiesRes(l.Default),
+		DefaultRequest: toResourceQuantitiesRes(l.DefaultRequest),
+	}
+}
+
+func toResourceQuantitiesRes(q k8s_client.ResourceQuantities) ResourceQuantitiesRes {
+	return ResourceQuantitiesRes{
+		CPU:              q.CPU,
+		Memory:           q.Memory,
+		GPU:              q.GPU,
+		EphemeralStorage: q.EphemeralStorage,
+		HugePages:        q.HugePages,
+	}
+}
@@ -6,27 +6,211 @@ type apiReq interface {
 	validate() error
 }
 
-type nfsPVReq struct {
-	pv k8s_client.NFSPersistentVolume
+type pvReq struct {
+	pv   k8s_client.PersistentVolume
+	opts k8s_client.CreateOptions
 }
 
-func (req nfsPVReq) validate() error {
+func (req pvReq) validate() error {
+	if err := req.opts.Validate(); err != nil {
+		return err
+	}
+
 	return req.pv.Validate()
 }
 
 type pvcReq struct {
-	pvc k8s_client.PersistentVolumeClaim
+	pvc  k8s_client.PersistentVolumeClaim
+	opts k8s_client.CreateOptions
 }
 
 func (req pvcReq) validate() error {
+	if err := req.opts.Validate(); err != nil {
+		return err
+	}
+
 	return req.pvc.Validate()
 }
 
 type deploymentReq struct {
 	deployment k8s_client.Deployment
+	opts       k8s_client.CreateOptions
 }
 
 func (req deploymentReq) validate() error {
+	if err := req.opts.Validate(); err != nil {
+		return err
+	}
+
 	return req.deployment.Validate()
 }
 
+type viewPVReq struct {
+	name string
+}
+
+func (req viewPVReq) validate() error {
+	if req.name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type listPVsReq struct{}
+
+func (req listPVsReq) validate() error {
+	return nil
+}
+
+type viewResourceReq struct {
+	namespace string
+	name      string
+}
+
+func (req viewResourceReq) validate() error {
+	if req.name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type listResourcesReq struct {
+	namespace string
+}
+
+func (req listResourcesReq) validate() error {
+	return nil
+}
+
+type deletePVReq struct {
+	name string
+	opts k8s_client.DeleteOptions
+}
+
+func (req deletePVReq) validate() error {
+	if req.name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.opts.Validate()
+}
+
+type deleteResourceReq struct {
+	namespace string
+	name      string
+	opts      k8s_client.DeleteOptions
+}
+
+func (req deleteResourceReq) validate() error {
+	if req.name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.opts.Validate()
+}
+
+type updateDeploymentReq struct {
+	deployment k8s_client.Deployment
+}
+
+func (req updateDeploymentReq) validate() error {
+	return req.deployment.ValidatePatch()
+}
+
+type scaleDeploymentReq struct {
+	namespace string
+	name      string
+	Replicas  *int32
+}
+
+func (req scaleDeploymentReq) validate() error {
+	if req.name == "" || req.Replicas == nil || *req.Replicas < 0 {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type jobReq struct {
+	job  k8s_client.Job
+	opts k8s_client.CreateOptions
+}
+
+func (req jobReq) validate() error {
+	if err := req.opts.Validate(); err != nil {
+		return err
+	}
+
+	return req.job.Validate()
+}
+
+type logsReq struct {
+	namespace string
+	name      string
+	opts      k8s_client.LogOptions
+}
+
+func (req logsReq) validate() error {
+	if req.name == "" {
+		return k8s_client.ErrMalformedEntity
+	}
+
+	return req.opts.Validate()
+}
+
+type registryCredentialReq struct {
+	cred k8s_client.RegistryCredential
+	opts k8s_client.CreateOptions
+}
+
+func (req registryCredentialReq) validate() error {
+	if err := req.opts.Validate(); err != nil {
+		return err
+	}
+
+	return req.cred.Validate()
+}
+
+type exposeReq struct {
+	exposure k8s_client.Exposure
+}
+
+func (req exposeReq) validate() error {
+	return req.exposure.Validate()
+}
+
+type configMapReq struct {
+	configMap k8s_client.ConfigMap
+	opts      k8s_client.CreateOptions
+}
+
+func (req configMapReq) validate() error {
+	if err := req.opts.Validate(); err != nil {
+		return err
+	}
+
+	return req.configMap.Validate()
+}
+
+type secretReq struct {
+	secret k8s_client.Secret
+	opts   k8s_client.CreateOptions
+}
+
+func (req secretReq) validate() error {
+	if err := req.opts.Validate(); err != nil {
+		return err
+	}
+
+	return req.secret.Validate()
+}
+
+type projectReq struct {
+	project k8s_client.Project
+}
+
+func (req projectReq) validate() error {
+	return req.project.Validate()
+}
@@ -2,6 +2,8 @@ package http
 
 import (
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/errors"
+	"github.com/hykuan/k8s-client-example/k8s-client"
 	"net/http"
 )
 
@@ -9,6 +11,32 @@ var (
 	_ quai.Response = (*PVRes)(nil)
 	_ quai.Response = (*PVCRes)(nil)
 	_ quai.Response = (*DeploymentRes)(nil)
+	_ quai.Response = (*ViewPVRes)(nil)
+	_ quai.Response = (*ListPVsRes)(nil)
+	_ quai.Response = (*ViewPVCRes)(nil)
+	_ quai.Response = (*ListPVCsRes)(nil)
+	_ quai.Response = (*ViewDeploymentRes)(nil)
+	_ quai.Response = (*ListDeploymentsRes)(nil)
+	_ quai.Response = (*DeleteRes)(nil)
+	_ quai.Response = (*UpdateDeploymentRes)(nil)
+	_ quai.Response = (*ScaleDeploymentRes)(nil)
+	_ quai.Response = (*JobRes)(nil)
+	_ quai.Response = (*NodeMetricsRes)(nil)
+	_ quai.Response = (*PodMetricsRes)(nil)
+	_ quai.Response = (*ClusterCapacityRes)(nil)
+	_ quai.Response = (*ScheduleRes)(nil)
+	_ quai.Response = (*RegistryCredentialRes)(nil)
+	_ quai.Response = (*ListRegistryCredentialsRes)(nil)
+	_ quai.Response = (*ExposeRes)(nil)
+	_ quai.Response = (*ConfigMapRes)(nil)
+	_ quai.Response = (*ViewConfigMapRes)(nil)
+	_ quai.Response = (*UpdateConfigMapRes)(nil)
+	_ quai.Response = (*SecretRes)(nil)
+	_ quai.Response = (*ViewSecretRes)(nil)
+	_ quai.Response = (*UpdateSecretRes)(nil)
+	_ quai.Response = (*ProjectRes)(nil)
+	_ quai.Response = (*ViewProjectRes)(nil)
+	_ quai.Response = (*UpdateProjectRes)(nil)
 )
 
 type PVRes struct {
@@ -58,3 +86,623 @@ func (res DeploymentRes) Headers() map[string]string {
 func (res DeploymentRes) Empty() bool {
 	return res.Name == ""
 }
+
+type ViewPVRes struct {
+	Name           string `json:"name"`
+	Storage        string `json:"storage,omitempty"`
+	Phase          string `json:"phase,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	ClaimNamespace string `json:"claimNamespace,omitempty"`
+	ClaimName      string `json:"claimName,omitempty"`
+}
+
+func (res ViewPVRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ViewPVRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ViewPVRes) Empty() bool {
+	return false
+}
+
+type ListPVsRes struct {
+	PersistentVolumes []ViewPVRes `json:"persistentVolumes"`
+}
+
+func (res ListPVsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ListPVsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ListPVsRes) Empty() bool {
+	return false
+}
+
+type ViewPVCRes struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Storage    string `json:"storage,omitempty"`
+	Phase      string `json:"phase,omitempty"`
+	VolumeName string `json:"volumeName,omitempty"`
+	Capacity   string `json:"capacity,omitempty"`
+}
+
+func (res ViewPVCRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ViewPVCRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ViewPVCRes) Empty() bool {
+	return false
+}
+
+type ListPVCsRes struct {
+	PersistentVolumeClaims []ViewPVCRes `json:"persistentVolumeClaims"`
+}
+
+func (res ListPVCsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ListPVCsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ListPVCsRes) Empty() bool {
+	return false
+}
+
+type ViewDeploymentRes struct {
+	Name                string `json:"name"`
+	Namespace           string `json:"namespace"`
+	Image               string `json:"image,omitempty"`
+	Replicas            int32  `json:"replicas"`
+	UpdatedReplicas     int32  `json:"updatedReplicas"`
+	ReadyReplicas       int32  `json:"readyReplicas"`
+	AvailableReplicas   int32  `json:"availableReplicas"`
+	UnavailableReplicas int32  `json:"unavailableReplicas"`
+}
+
+func (res ViewDeploymentRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ViewDeploymentRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ViewDeploymentRes) Empty() bool {
+	return false
+}
+
+type ListDeploymentsRes struct {
+	Deployments []ViewDeploymentRes `json:"deployments"`
+}
+
+func (res ListDeploymentsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ListDeploymentsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ListDeploymentsRes) Empty() bool {
+	return false
+}
+
+type DeleteRes struct{}
+
+func (res DeleteRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res DeleteRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res DeleteRes) Empty() bool {
+	return true
+}
+
+type UpdateDeploymentRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res UpdateDeploymentRes) Code() int {
+	return http.StatusOK
+}
+
+func (res UpdateDeploymentRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res UpdateDeploymentRes) Empty() bool {
+	return res.Name == ""
+}
+
+type ScaleDeploymentRes struct {
+	Name     string `json:"name"`
+	Replicas int32  `json:"replicas"`
+}
+
+func (res ScaleDeploymentRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ScaleDeploymentRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ScaleDeploymentRes) Empty() bool {
+	return false
+}
+
+type JobRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res JobRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res JobRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res JobRes) Empty() bool {
+	return res.Name == ""
+}
+
+type NodeUsageRes struct {
+	Name      string `json:"name"`
+	CPU       string `json:"cpu,omitempty"`
+	Memory    string `json:"memory,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+type NodeMetricsRes struct {
+	Nodes []NodeUsageRes `json:"nodes"`
+}
+
+func (res NodeMetricsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res NodeMetricsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res NodeMetricsRes) Empty() bool {
+	return false
+}
+
+type ContainerUsageRes struct {
+	Name   string `json:"name"`
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
+}
+
+type PodUsageRes struct {
+	Name       string              `json:"name"`
+	Namespace  string              `json:"namespace"`
+	CPU        string              `json:"cpu,omitempty"`
+	Memory     string              `json:"memory,omitempty"`
+	Timestamp  string              `json:"timestamp,omitempty"`
+	Containers []ContainerUsageRes `json:"containers,omitempty"`
+}
+
+type PodMetricsRes struct {
+	Pods []PodUsageRes `json:"pods"`
+}
+
+func (res PodMetricsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res PodMetricsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res PodMetricsRes) Empty() bool {
+	return false
+}
+
+type ResourceAmountsRes struct {
+	CPU    string `json:"cpu"`
+	Memory string `json:"memory"`
+	GPU    string `json:"gpu"`
+}
+
+type NodeCapacityRes struct {
+	Name        string             `json:"name"`
+	GPUModel    string             `json:"gpuModel,omitempty"`
+	Schedulable bool               `json:"schedulable"`
+	Allocatable ResourceAmountsRes `json:"allocatable"`
+	Requested   ResourceAmountsRes `json:"requested"`
+}
+
+type ClusterCapacityRes struct {
+	Nodes []NodeCapacityRes `json:"nodes"`
+}
+
+func (res ClusterCapacityRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ClusterCapacityRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ClusterCapacityRes) Empty() bool {
+	return false
+}
+
+type ScheduleRes struct {
+	Schedulable bool     `json:"schedulable"`
+	Nodes       []string `json:"nodes"`
+	Reason      string   `json:"reason,omitempty"`
+}
+
+func (res ScheduleRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ScheduleRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ScheduleRes) Empty() bool {
+	return false
+}
+
+type ContainerStateRes struct {
+	Name     string `json:"name"`
+	State    string `json:"state,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	Message  string `json:"message,omitempty"`
+	ExitCode int32  `json:"exitCode,omitempty"`
+}
+
+// WorkloadEventRes is a single server-sent event of a watch stream.
+type WorkloadEventRes struct {
+	Type              string              `json:"type"`
+	Kind              string              `json:"kind"`
+	Name              string              `json:"name"`
+	Namespace         string              `json:"namespace"`
+	Replicas          int32               `json:"replicas,omitempty"`
+	ReadyReplicas     int32               `json:"readyReplicas,omitempty"`
+	AvailableReplicas int32               `json:"availableReplicas,omitempty"`
+	Active            int32               `json:"active,omitempty"`
+	Succeeded         int32               `json:"succeeded,omitempty"`
+	Failed            int32               `json:"failed,omitempty"`
+	Phase             string              `json:"phase,omitempty"`
+	Containers        []ContainerStateRes `json:"containers,omitempty"`
+}
+
+func toWorkloadEventRes(e k8s_client.WorkloadEvent) WorkloadEventRes {
+	res := WorkloadEventRes{
+		Type:              e.Type,
+		Kind:              e.Kind,
+		Name:              e.Name,
+		Namespace:         e.Namespace,
+		Replicas:          e.Replicas,
+		ReadyReplicas:     e.ReadyReplicas,
+		AvailableReplicas: e.AvailableReplicas,
+		Active:            e.Active,
+		Succeeded:         e.Succeeded,
+		Failed:            e.Failed,
+		Phase:             e.Phase,
+	}
+
+	for _, c := range e.Containers {
+		res.Containers = append(res.Containers, ContainerStateRes{
+			Name:     c.Name,
+			State:    c.State,
+			Reason:   c.Reason,
+			Message:  c.Message,
+			ExitCode: c.ExitCode,
+		})
+	}
+
+	return res
+}
+
+// LogLineRes is a single line of a log stream.
+type LogLineRes struct {
+	Pod       string `json:"pod"`
+	Container string `json:"container,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+	Message   string `json:"message"`
+}
+
+func toLogLineRes(l k8s_client.LogLine) LogLineRes {
+	return LogLineRes{
+		Pod:       l.Pod,
+		Container: l.Container,
+		Timestamp: l.Timestamp,
+		Message:   l.Message,
+	}
+}
+
+type RegistryCredentialRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res RegistryCredentialRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res RegistryCredentialRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res RegistryCredentialRes) Empty() bool {
+	return res.Name == ""
+}
+
+// ViewRegistryCredentialRes describes a registry credential without its
+// token.
+type ViewRegistryCredentialRes struct {
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Servers   []string `json:"servers"`
+	Username  string   `json:"username,omitempty"`
+}
+
+type ListRegistryCredentialsRes struct {
+	RegistryCredentials []ViewRegistryCredentialRes `json:"registryCredentials"`
+}
+
+func (res ListRegistryCredentialsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ListRegistryCredentialsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ListRegistryCredentialsRes) Empty() bool {
+	return false
+}
+
+type ExposeRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res ExposeRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res ExposeRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ExposeRes) Empty() bool {
+	return res.Name == ""
+}
+
+type ConfigMapRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res ConfigMapRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res ConfigMapRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ConfigMapRes) Empty() bool {
+	return res.Name == ""
+}
+
+type ViewConfigMapRes struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Data      map[string]string `json:"data"`
+}
+
+func (res ViewConfigMapRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ViewConfigMapRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ViewConfigMapRes) Empty() bool {
+	return false
+}
+
+type UpdateConfigMapRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res UpdateConfigMapRes) Code() int {
+	return http.StatusOK
+}
+
+func (res UpdateConfigMapRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res UpdateConfigMapRes) Empty() bool {
+	return res.Name == ""
+}
+
+type SecretRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res SecretRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res SecretRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res SecretRes) Empty() bool {
+	return res.Name == ""
+}
+
+// ViewSecretRes describes a secret by its keys. Secret values are never
+// returned.
+type ViewSecretRes struct {
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Type      string   `json:"type"`
+	Keys      []string `json:"keys"`
+}
+
+func (res ViewSecretRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ViewSecretRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ViewSecretRes) Empty() bool {
+	return false
+}
+
+type UpdateSecretRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res UpdateSecretRes) Code() int {
+	return http.StatusOK
+}
+
+func (res UpdateSecretRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res UpdateSecretRes) Empty() bool {
+	return res.Name == ""
+}
+
+type ProjectRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res ProjectRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res ProjectRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ProjectRes) Empty() bool {
+	return res.Name == ""
+}
+
+type ProjectQuotaRes struct {
+	CPU     string `json:"cpu,omitempty"`
+	Memory  string `json:"memory,omitempty"`
+	GPU     string `json:"gpu,omitempty"`
+	PVCs    string `json:"pvcs,omitempty"`
+	Storage string `json:"storage,omitempty"`
+}
+
+type ResourceQuantitiesRes struct {
+	CPU              string            `json:"cpu,omitempty"`
+	Memory           string            `json:"memory,omitempty"`
+	GPU              string            `json:"gpu,omitempty"`
+	EphemeralStorage string            `json:"ephemeralStorage,omitempty"`
+	HugePages        map[string]string `json:"hugePages,omitempty"`
+}
+
+type ProjectLimitsRes struct {
+	Default        ResourceQuantitiesRes `json:"default"`
+	DefaultRequest ResourceQuantitiesRes `json:"defaultRequest"`
+}
+
+// ViewProjectRes describes a project. Quota is the hard limit of each bounded
+// resource and Used how much of it is taken.
+type ViewProjectRes struct {
+	Name           string           `json:"name"`
+	Phase          string           `json:"phase"`
+	Quota          ProjectQuotaRes  `json:"quota"`
+	Used           ProjectQuotaRes  `json:"used"`
+	Limits         ProjectLimitsRes `json:"limits"`
+	ServiceAccount string           `json:"serviceAccount"`
+}
+
+func (res ViewProjectRes) Code() int {
+	return http.StatusOK
+}
+
+func (res ViewProjectRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res ViewProjectRes) Empty() bool {
+	return false
+}
+
+type UpdateProjectRes struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (res UpdateProjectRes) Code() int {
+	return http.StatusOK
+}
+
+func (res UpdateProjectRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res UpdateProjectRes) Empty() bool {
+	return res.Name == ""
+}
+
+// ManifestRes carries the manifest rendered by a dry run of a create request.
+// It is written as is rather than encoded as JSON.
+type ManifestRes struct {
+	Manifest string
+	Output   string
+}
+
+func (res ManifestRes) contentType() string {
+	if res.Output == k8s_client.OutputYAML {
+		return "application/yaml"
+	}
+
+	return contentType
+}
+
+// ErrorRes explains why a request was rejected. Code classifies the error
+// and Fields name the fields at fault, if any.
+type ErrorRes struct {
+	Code   errors.Code `json:"code"`
+	Error  string      `json:"error"`
+	Fields []FieldRes  `json:"fields,omitempty"`
+}
+
+type FieldRes struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+func toErrorRes(e *errors.Error) ErrorRes {
+	res := ErrorRes{Code: e.Code, Error: e.Error()}
+	for _, f := range e.Fields {
+		res.Fields = append(res.Fields, FieldRes{Field: f.Field, Reason: f.Reason})
+	}
+
+	return res
+}
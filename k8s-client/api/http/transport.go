@@ -3,77 +3,488 @@ package http
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/auth"
+	"github.com/hykuan/k8s-client-example/errors"
 	"github.com/hykuan/k8s-client-example/k8s-client"
 	"io"
 	"net/http"
+	"strconv"
 
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/go-zoo/bone"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/qeek-dev/quaistudio/logger"
-	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 const contentType = "application/json"
 
 var (
-	errUnsupportedContentType = errors.New("unsupported content type")
+	errUnsupportedContentType = errors.New(errors.Invalid, "unsupported content type")
+	errStreamingUnsupported   = errors.New(errors.Internal, "streaming unsupported")
 	logger                    log.Logger
 )
 
-// MakeHandler returns a HTTP handler for API endpoints.
-func MakeHandler(svc k8s_client.Service, l log.Logger) http.Handler {
+// MakeHandler returns a HTTP handler for API endpoints. All endpoints but
+// version and metrics require a bearer token accepted by authn.
+func MakeHandler(svc k8s_client.Service, authn auth.Authenticator, l log.Logger) http.Handler {
 	logger = l
 
+	authenticate := auth.Middleware(authn)
 	opts := []kithttp.ServerOption{
+		kithttp.ServerBefore(auth.HTTPToContext),
 		kithttp.ServerErrorEncoder(encodeError),
 	}
 
 	mux := bone.New()
 
 	mux.Post("/pv", kithttp.NewServer(
-		createNFSPVEndpoint(svc),
-		decodeNFSPersistentVolume,
+		authenticate(createPVEndpoint(svc)),
+		decodePersistentVolume,
 		encodeResponse,
 		opts...,
 	))
 
 	mux.Post("/pvc", kithttp.NewServer(
-		createPVCEndpoint(svc),
+		authenticate(createPVCEndpoint(svc)),
 		decodePersistentVolumeClaim,
 		encodeResponse,
 		opts...,
 	))
 
 	mux.Post("/deployment", kithttp.NewServer(
-		createDeploymentEndpoint(svc),
+		authenticate(createDeploymentEndpoint(svc)),
 		decodeDeployment,
 		encodeResponse,
 		opts...,
 	))
 
+	mux.Post("/job", kithttp.NewServer(
+		authenticate(createJobEndpoint(svc)),
+		decodeJob,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/pv", kithttp.NewServer(
+		authenticate(listPVsEndpoint(svc)),
+		decodeListPVs,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/pv/:name", kithttp.NewServer(
+		authenticate(viewPVEndpoint(svc)),
+		decodeViewPV,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/pvc", kithttp.NewServer(
+		authenticate(listPVCsEndpoint(svc)),
+		decodeListResources,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/pvc/:name", kithttp.NewServer(
+		authenticate(viewPVCEndpoint(svc)),
+		decodeViewResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/deployment", kithttp.NewServer(
+		authenticate(listDeploymentsEndpoint(svc)),
+		decodeListResources,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/deployment/:name", kithttp.NewServer(
+		authenticate(viewDeploymentEndpoint(svc)),
+		decodeViewResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/pv/:name", kithttp.NewServer(
+		authenticate(deletePVEndpoint(svc)),
+		decodeDeletePV,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/pvc/:name", kithttp.NewServer(
+		authenticate(deletePVCEndpoint(svc)),
+		decodeDeleteResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/deployment/:name", kithttp.NewServer(
+		authenticate(deleteDeploymentEndpoint(svc)),
+		decodeDeleteResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Patch("/deployment/:name", kithttp.NewServer(
+		authenticate(updateDeploymentEndpoint(svc)),
+		decodeUpdateDeployment,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/deployment/:name/expose", kithttp.NewServer(
+		authenticate(exposeDeploymentEndpoint(svc)),
+		decodeExposure,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Put("/deployment/:name/scale", kithttp.NewServer(
+		authenticate(scaleDeploymentEndpoint(svc)),
+		decodeScaleDeployment,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/metrics/nodes", kithttp.NewServer(
+		authenticate(nodeMetricsEndpoint(svc)),
+		decodeNodeMetrics,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/deployment/:name/usage", kithttp.NewServer(
+		authenticate(podMetricsEndpoint(svc)),
+		decodeViewResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/job/:name/usage", kithttp.NewServer(
+		authenticate(podMetricsEndpoint(svc)),
+		decodeViewResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/capacity", kithttp.NewServer(
+		authenticate(clusterCapacityEndpoint(svc)),
+		decodeClusterCapacity,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/capacity/check", kithttp.NewServer(
+		authenticate(canScheduleEndpoint(svc)),
+		decodeDeployment,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/registry", kithttp.NewServer(
+		authenticate(createRegistryCredentialEndpoint(svc)),
+		decodeRegistryCredential,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/registry", kithttp.NewServer(
+		authenticate(listRegistryCredentialsEndpoint(svc)),
+		decodeListResources,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/registry/:name", kithttp.NewServer(
+		authenticate(deleteRegistryCredentialEndpoint(svc)),
+		decodeDeleteResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/configmap", kithttp.NewServer(
+		authenticate(createConfigMapEndpoint(svc)),
+		decodeConfigMap,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/configmap/:name", kithttp.NewServer(
+		authenticate(viewConfigMapEndpoint(svc)),
+		decodeViewResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Put("/configmap/:name", kithttp.NewServer(
+		authenticate(updateConfigMapEndpoint(svc)),
+		decodeUpdateConfigMap,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/configmap/:name", kithttp.NewServer(
+		authenticate(deleteConfigMapEndpoint(svc)),
+		decodeDeleteResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/secret", kithttp.NewServer(
+		authenticate(createSecretEndpoint(svc)),
+		decodeSecret,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/secret/:name", kithttp.NewServer(
+		authenticate(viewSecretEndpoint(svc)),
+		decodeViewResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Put("/secret/:name", kithttp.NewServer(
+		authenticate(updateSecretEndpoint(svc)),
+		decodeUpdateSecret,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/secret/:name", kithttp.NewServer(
+		authenticate(deleteSecretEndpoint(svc)),
+		decodeDeleteResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/project", kithttp.NewServer(
+		authenticate(createProjectEndpoint(svc)),
+		decodeProject,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/project/:name", kithttp.NewServer(
+		authenticate(viewProjectEndpoint(svc)),
+		decodeViewResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Put("/project/:name", kithttp.NewServer(
+		authenticate(updateProjectEndpoint(svc)),
+		decodeUpdateProject,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/project/:name", kithttp.NewServer(
+		authenticate(deleteProjectEndpoint(svc)),
+		decodeDeleteResource,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.GetFunc("/deployment/:name/watch", authenticated(authn, watchHandler(svc.WatchDeployment)))
+	mux.GetFunc("/job/:name/watch", authenticated(authn, watchHandler(svc.WatchJob)))
+	mux.GetFunc("/deployment/:name/logs", authenticated(authn, logsHandler(svc)))
+	mux.GetFunc("/job/:name/logs", authenticated(authn, logsHandler(svc)))
+
 	mux.GetFunc("/version", quai.Version("k8s-client"))
 	mux.Handle("/metrics", promhttp.Handler())
 
 	return mux
 }
 
-func decodeNFSPersistentVolume(_ context.Context, r *http.Request) (interface{}, error) {
+// authenticated guards the streaming handlers, which aren't go-kit endpoints,
+// the same way the endpoint middleware guards the others.
+func authenticated(authn auth.Authenticator, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := auth.Authenticate(auth.HTTPToContext(r.Context(), r), authn)
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		next(w, r.WithContext(ctx))
+	}
+}
+
+type watchFunc func(ctx context.Context, namespace, name string) (<-chan k8s_client.WorkloadEvent, error)
+
+// watchHandler streams workload events as server-sent events until the
+// client disconnects or the watch ends. A watch that fails ends with an
+// "error" event holding an ErrorRes.
+func watchHandler(watch watchFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		req := viewResourceReq{
+			namespace: r.URL.Query().Get("namespace"),
+			name:      bone.GetValue(r, "name"),
+		}
+		if err := req.validate(); err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			encodeError(ctx, errStreamingUnsupported, w)
+			return
+		}
+
+		events, err := watch(ctx, req.namespace, req.name)
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for e := range events {
+			if e.Err != nil {
+				data, _ := json.Marshal(toErrorRes(errors.From(e.Err)))
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+				flusher.Flush()
+				return
+			}
+
+			data, err := json.Marshal(toWorkloadEventRes(e))
+			if err != nil {
+				logger.Warn(fmt.Sprintf("Failed to encode watch event: %s", err))
+				continue
+			}
+
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// logsHandler streams workload logs as newline-delimited JSON until the
+// client disconnects, a pod log fails, which ends the stream with an ErrorRes,
+// or, when not following, the logs are exhausted.
+func logsHandler(svc k8s_client.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		req, err := decodeLogs(r)
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		if err := req.validate(); err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			encodeError(ctx, errStreamingUnsupported, w)
+			return
+		}
+
+		lines, err := svc.StreamLogs(ctx, req.namespace, req.name, req.opts)
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		encoder := json.NewEncoder(w)
+		for l := range lines {
+			// The status is already sent, so a pod log that can't be read to
+			// the end is reported by an ErrorRes closing the stream.
+			var res interface{} = toLogLineRes(l)
+			if l.Err != nil {
+				res = toErrorRes(errors.From(l.Err))
+			}
+			if err := encoder.Encode(res); err != nil || l.Err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func decodeLogs(r *http.Request) (logsReq, error) {
+	query := r.URL.Query()
+	req := logsReq{
+		namespace: query.Get("namespace"),
+		name:      bone.GetValue(r, "name"),
+		opts: k8s_client.LogOptions{
+			Container: query.Get("container"),
+		},
+	}
+
+	if value := query.Get("follow"); value != "" {
+		follow, err := strconv.ParseBool(value)
+		if err != nil {
+			return logsReq{}, k8s_client.ErrMalformedEntity
+		}
+		req.opts.Follow = follow
+	}
+
+	if value := query.Get("tailLines"); value != "" {
+		lines, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return logsReq{}, k8s_client.ErrMalformedEntity
+		}
+		req.opts.TailLines = &lines
+	}
+
+	return req, nil
+}
+
+// decodePersistentVolume accepts a volume with a typed source as well as the
+// flat NFS body (server and path next to name and storage) used before.
+func decodePersistentVolume(_ context.Context, r *http.Request) (interface{}, error) {
 	if r.Header.Get("Content-Type") != contentType {
 		logger.Warn("Invalid or missing content type.")
 		return nil, errUnsupportedContentType
 	}
 
-	var pv k8s_client.NFSPersistentVolume
-	if err := json.NewDecoder(r.Body).Decode(&pv); err != nil {
+	var body struct {
+		k8s_client.PersistentVolume
+		Server string
+		Path   string
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		logger.Warn(fmt.Sprintf("Failed to decode persistent volume: %s", err))
 		return nil, err
 	}
 
-	return nfsPVReq{pv}, nil
+	pv := body.PersistentVolume
+	if body.Server != "" || body.Path != "" {
+		if pv.Source != (k8s_client.VolumeSource{}) {
+			return nil, k8s_client.ErrMalformedEntity
+		}
+		pv.Source.NFS = &k8s_client.NFSVolumeSource{Server: body.Server, Path: body.Path}
+	}
+
+	opts, err := readCreateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return pvReq{pv, opts}, nil
 }
 
 func decodePersistentVolumeClaim(_ context.Context, r *http.Request) (interface{}, error) {
@@ -88,7 +499,12 @@ func decodePersistentVolumeClaim(_ context.Context, r *http.Request) (interface{
 		return nil, err
 	}
 
-	return pvcReq{pvc}, nil
+	opts, err := readCreateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return pvcReq{pvc, opts}, nil
 }
 
 func decodeDeployment(_ context.Context, r *http.Request) (interface{}, error) {
@@ -103,10 +519,328 @@ func decodeDeployment(_ context.Context, r *http.Request) (interface{}, error) {
 		return nil, err
 	}
 
-	return deploymentReq{deployment}, nil
+	opts, err := readCreateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return deploymentReq{deployment, opts}, nil
+}
+
+func decodeJob(_ context.Context, r *http.Request) (interface{}, error) {
+	if r.Header.Get("Content-Type") != contentType {
+		logger.Warn("Invalid or missing content type.")
+		return nil, errUnsupportedContentType
+	}
+
+	var job k8s_client.Job
+	if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to decode job: %s", err))
+		return nil, err
+	}
+
+	opts, err := readCreateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobReq{job, opts}, nil
+}
+
+func decodeRegistryCredential(_ context.Context, r *http.Request) (interface{}, error) {
+	if r.Header.Get("Content-Type") != contentType {
+		logger.Warn("Invalid or missing content type.")
+		return nil, errUnsupportedContentType
+	}
+
+	var cred k8s_client.RegistryCredential
+	if err := json.NewDecoder(r.Body).Decode(&cred); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to decode registry credential: %s", err))
+		return nil, err
+	}
+
+	opts, err := readCreateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return registryCredentialReq{cred, opts}, nil
+}
+
+func decodeConfigMap(_ context.Context, r *http.Request) (interface{}, error) {
+	cm, err := readConfigMap(r)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := readCreateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return configMapReq{cm, opts}, nil
+}
+
+// decodeUpdateConfigMap only reads the body, since the create options don't
+// apply to updates.
+func decodeUpdateConfigMap(_ context.Context, r *http.Request) (interface{}, error) {
+	cm, err := readConfigMap(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cm.Name = bone.GetValue(r, "name")
+	if cm.Namespace == "" {
+		cm.Namespace = r.URL.Query().Get("namespace")
+	}
+
+	return configMapReq{configMap: cm}, nil
+}
+
+func readConfigMap(r *http.Request) (k8s_client.ConfigMap, error) {
+	var cm k8s_client.ConfigMap
+	if r.Header.Get("Content-Type") != contentType {
+		logger.Warn("Invalid or missing content type.")
+		return cm, errUnsupportedContentType
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&cm); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to decode config map: %s", err))
+		return cm, err
+	}
+
+	return cm, nil
+}
+
+func decodeSecret(_ context.Context, r *http.Request) (interface{}, error) {
+	secret, err := readSecret(r)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := readCreateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return secretReq{secret, opts}, nil
+}
+
+// decodeUpdateSecret only reads the body, since the create options don't
+// apply to updates.
+func decodeUpdateSecret(_ context.Context, r *http.Request) (interface{}, error) {
+	secret, err := readSecret(r)
+	if err != nil {
+		return nil, err
+	}
+
+	secret.Name = bone.GetValue(r, "name")
+	if secret.Namespace == "" {
+		secret.Namespace = r.URL.Query().Get("namespace")
+	}
+
+	return secretReq{secret: secret}, nil
+}
+
+func readSecret(r *http.Request) (k8s_client.Secret, error) {
+	var secret k8s_client.Secret
+	if r.Header.Get("Content-Type") != contentType {
+		logger.Warn("Invalid or missing content type.")
+		return secret, errUnsupportedContentType
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&secret); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to decode secret: %s", err))
+		return secret, err
+	}
+
+	return secret, nil
+}
+
+func decodeProject(_ context.Context, r *http.Request) (interface{}, error) {
+	if r.Header.Get("Content-Type") != contentType {
+		logger.Warn("Invalid or missing content type.")
+		return nil, errUnsupportedContentType
+	}
+
+	var project k8s_client.Project
+	if err := json.NewDecoder(r.Body).Decode(&project); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to decode project: %s", err))
+		return nil, err
+	}
+
+	return projectReq{project}, nil
+}
+
+func decodeUpdateProject(ctx context.Context, r *http.Request) (interface{}, error) {
+	req, err := decodeProject(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	project := req.(projectReq).project
+	project.Name = bone.GetValue(r, "name")
+
+	return projectReq{project}, nil
+}
+
+func decodeUpdateDeployment(_ context.Context, r *http.Request) (interface{}, error) {
+	if r.Header.Get("Content-Type") != contentType {
+		logger.Warn("Invalid or missing content type.")
+		return nil, errUnsupportedContentType
+	}
+
+	var deployment k8s_client.Deployment
+	if err := json.NewDecoder(r.Body).Decode(&deployment); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to decode deployment: %s", err))
+		return nil, err
+	}
+
+	deployment.Name = bone.GetValue(r, "name")
+	if deployment.Namespace == "" {
+		deployment.Namespace = r.URL.Query().Get("namespace")
+	}
+
+	return updateDeploymentReq{deployment}, nil
+}
+
+func decodeExposure(_ context.Context, r *http.Request) (interface{}, error) {
+	if r.Header.Get("Content-Type") != contentType {
+		logger.Warn("Invalid or missing content type.")
+		return nil, errUnsupportedContentType
+	}
+
+	var exposure k8s_client.Exposure
+	if err := json.NewDecoder(r.Body).Decode(&exposure); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to decode exposure: %s", err))
+		return nil, err
+	}
+
+	exposure.Name = bone.GetValue(r, "name")
+	if exposure.Namespace == "" {
+		exposure.Namespace = r.URL.Query().Get("namespace")
+	}
+
+	return exposeReq{exposure}, nil
+}
+
+func decodeScaleDeployment(_ context.Context, r *http.Request) (interface{}, error) {
+	if r.Header.Get("Content-Type") != contentType {
+		logger.Warn("Invalid or missing content type.")
+		return nil, errUnsupportedContentType
+	}
+
+	req := scaleDeploymentReq{
+		namespace: r.URL.Query().Get("namespace"),
+		name:      bone.GetValue(r, "name"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to decode scale request: %s", err))
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func decodeViewPV(_ context.Context, r *http.Request) (interface{}, error) {
+	return viewPVReq{name: bone.GetValue(r, "name")}, nil
+}
+
+func decodeListPVs(_ context.Context, _ *http.Request) (interface{}, error) {
+	return listPVsReq{}, nil
+}
+
+func decodeNodeMetrics(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeClusterCapacity(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+func decodeViewResource(_ context.Context, r *http.Request) (interface{}, error) {
+	req := viewResourceReq{
+		namespace: r.URL.Query().Get("namespace"),
+		name:      bone.GetValue(r, "name"),
+	}
+
+	return req, nil
+}
+
+func decodeListResources(_ context.Context, r *http.Request) (interface{}, error) {
+	return listResourcesReq{namespace: r.URL.Query().Get("namespace")}, nil
+}
+
+func decodeDeletePV(_ context.Context, r *http.Request) (interface{}, error) {
+	opts, err := readDeleteOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return deletePVReq{name: bone.GetValue(r, "name"), opts: opts}, nil
+}
+
+func decodeDeleteResource(_ context.Context, r *http.Request) (interface{}, error) {
+	opts, err := readDeleteOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := deleteResourceReq{
+		namespace: r.URL.Query().Get("namespace"),
+		name:      bone.GetValue(r, "name"),
+		opts:      opts,
+	}
+
+	return req, nil
+}
+
+// readCreateOptions reads the apply query parameter, which makes a create
+// request update the entity if it already exists, and the dryRun and output
+// parameters, which make it return the rendered manifest instead.
+func readCreateOptions(r *http.Request) (k8s_client.CreateOptions, error) {
+	query := r.URL.Query()
+	opts := k8s_client.CreateOptions{
+		DryRun: query.Get("dryRun"),
+		Output: query.Get("output"),
+	}
+
+	if value := query.Get("apply"); value != "" {
+		apply, err := strconv.ParseBool(value)
+		if err != nil {
+			return k8s_client.CreateOptions{}, k8s_client.ErrMalformedEntity
+		}
+		opts.Apply = apply
+	}
+
+	return opts, nil
+}
+
+func readDeleteOptions(r *http.Request) (k8s_client.DeleteOptions, error) {
+	query := r.URL.Query()
+	opts := k8s_client.DeleteOptions{
+		PropagationPolicy: query.Get("propagationPolicy"),
+	}
+
+	if value := query.Get("gracePeriodSeconds"); value != "" {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return k8s_client.DeleteOptions{}, k8s_client.ErrMalformedEntity
+		}
+		opts.GracePeriodSeconds = &seconds
+	}
+
+	return opts, nil
 }
 
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	if mr, ok := response.(ManifestRes); ok {
+		w.Header().Set("Content-Type", mr.contentType())
+		w.WriteHeader(http.StatusOK)
+		_, err := io.WriteString(w, mr.Manifest)
+		return err
+	}
+
 	w.Header().Set("Content-Type", contentType)
 
 	if ar, ok := response.(quai.Response); ok {
@@ -125,32 +859,21 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 }
 
 func encodeError(_ context.Context, err error, w http.ResponseWriter) {
-	w.Header().Set("Content-Type", contentType)
+	switch err.(type) {
+	case *json.SyntaxError, *json.UnmarshalTypeError:
+		err = k8s_client.ErrMalformedEntity
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = k8s_client.ErrMalformedEntity
+	}
 
-	switch err {
-	case k8s_client.ErrMalformedEntity:
-		w.WriteHeader(http.StatusBadRequest)
-	case k8s_client.ErrUnauthorizedAccess:
-		w.WriteHeader(http.StatusForbidden)
-	case k8s_client.ErrConflict:
-		w.WriteHeader(http.StatusConflict)
-	case errUnsupportedContentType:
-		w.WriteHeader(http.StatusUnsupportedMediaType)
-	case io.ErrUnexpectedEOF:
-		w.WriteHeader(http.StatusBadRequest)
-	case io.EOF:
-		w.WriteHeader(http.StatusBadRequest)
-	default:
-		if  statusError, isStatus := err.(*k8sErrors.StatusError); isStatus {
-			w.WriteHeader(int(statusError.Status().Code))
-		}
-		switch err.(type) {
-		case *json.SyntaxError:
-			w.WriteHeader(http.StatusBadRequest)
-		case *json.UnmarshalTypeError:
-			w.WriteHeader(http.StatusBadRequest)
-		default:
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+	e := errors.From(err)
+	status := e.Code.HTTPStatus()
+	if err == errUnsupportedContentType {
+		status = http.StatusUnsupportedMediaType
 	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(toErrorRes(e))
 }
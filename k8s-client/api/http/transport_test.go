@@ -0,0 +1,266 @@
+package http_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hykuan/k8s-client-example/auth"
+	"github.com/hykuan/k8s-client-example/errors"
+	"github.com/hykuan/k8s-client-example/k8s-client"
+	httpapi "github.com/hykuan/k8s-client-example/k8s-client/api/http"
+	"github.com/hykuan/k8s-client-example/k8s-client/mocks"
+	log "github.com/qeek-dev/quaistudio/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	namespace = "training"
+	name      = "mnist"
+	secret    = "0123456789abcdef0123456789abcdef"
+)
+
+// newServer serves the HTTP API of h and returns it along with a token it
+// accepts.
+func newServer(t *testing.T, h mocks.Harness) (*httptest.Server, string) {
+	logger, err := log.New(ioutil.Discard, "error")
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating logger: %s", err))
+
+	authn, err := auth.New(secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating authenticator: %s", err))
+
+	token, err := authn.Issue(auth.Identity{Subject: "alice"}, time.Hour)
+	require.Nil(t, err, fmt.Sprintf("unexpected error issuing token: %s", err))
+
+	return httptest.NewServer(httpapi.MakeHandler(h.Service, authn, logger)), token
+}
+
+func request(t *testing.T, method, url, token, body string) *http.Response {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating request: %s", err))
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.Nil(t, err, fmt.Sprintf("unexpected error sending request: %s", err))
+
+	return res
+}
+
+func TestUpdateIgnoresCreateOptions(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	_, err := h.Service.CreateConfigMap(context.Background(), k8s_client.ConfigMap{Name: name, Data: map[string]string{"epochs": "10"}}, k8s_client.CreateOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	_, err = h.Service.CreateSecret(context.Background(), k8s_client.Secret{Name: name, Data: map[string]string{"token": "hunter2"}}, k8s_client.CreateOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	server, token := newServer(t, h)
+	defer server.Close()
+
+	cases := map[string]struct {
+		url  string
+		body string
+	}{
+		"update config map with create options": {
+			url:  "/configmap/mnist?apply=maybe&dryRun=never&output=xml",
+			body: `{"data":{"epochs":"20"}}`,
+		},
+		"update secret with create options": {
+			url:  "/secret/mnist?apply=maybe&dryRun=never&output=xml",
+			body: `{"data":{"token":"hunter3"}}`,
+		},
+	}
+
+	for desc, tc := range cases {
+		res := request(t, http.MethodPut, server.URL+tc.url, token, tc.body)
+		res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("%s: expected status %d got %d", desc, http.StatusOK, res.StatusCode))
+	}
+
+	cm, err := h.Service.GetConfigMap(context.Background(), namespace, name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, "20", cm.Data["epochs"], "config map not updated")
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	server, token := newServer(t, mocks.NewHarness(namespace))
+	defer server.Close()
+
+	for _, url := range []string{"/pv/unknown", "/pvc/unknown", "/deployment/unknown"} {
+		res := request(t, http.MethodDelete, server.URL+url, token, "")
+
+		var body httpapi.ErrorRes
+		err := json.NewDecoder(res.Body).Decode(&body)
+		res.Body.Close()
+		require.Nil(t, err, fmt.Sprintf("delete %s: unexpected error decoding response: %s", url, err))
+
+		assert.Equal(t, http.StatusNotFound, res.StatusCode, fmt.Sprintf("delete %s: expected status %d got %d", url, http.StatusNotFound, res.StatusCode))
+		assert.Equal(t, errors.NotFound, body.Code, fmt.Sprintf("delete %s: expected code %s got %s", url, errors.NotFound, body.Code))
+	}
+}
+
+func TestWatchRoute(t *testing.T) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: "10"}}
+	h := mocks.NewHarness(namespace, deployment)
+	h.ClientSet.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &apiv1.PodList{ListMeta: metav1.ListMeta{ResourceVersion: "10"}}, nil
+	})
+	watcher := watch.NewFake()
+	h.ClientSet.PrependWatchReactor("deployments", k8stesting.DefaultWatchReactor(watcher, nil))
+
+	server, token := newServer(t, h)
+	defer server.Close()
+
+	res := request(t, http.MethodGet, server.URL+"/deployment/unknown/watch", token, "")
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, fmt.Sprintf("watch unknown deployment: expected status %d got %d", http.StatusNotFound, res.StatusCode))
+
+	res = request(t, http.MethodGet, server.URL+"/deployment/mnist/watch", token, "")
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode, "watch deployment: unexpected status")
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"), "watch deployment: wrong content type")
+	events := bufio.NewReader(res.Body)
+
+	var event httpapi.WorkloadEventRes
+	typ := readEvent(t, events, &event)
+	assert.Equal(t, "ADDED", typ, "wrong initial event type")
+	assert.Equal(t, httpapi.WorkloadEventRes{Type: "ADDED", Kind: k8s_client.KindDeployment, Name: name, Namespace: namespace}, event, "wrong initial event")
+
+	ready := deployment.DeepCopy()
+	ready.ResourceVersion, ready.Status.ReadyReplicas = "11", 1
+	go watcher.Modify(ready)
+
+	event = httpapi.WorkloadEventRes{}
+	typ = readEvent(t, events, &event)
+	assert.Equal(t, "MODIFIED", typ, "wrong event type")
+	assert.Equal(t, int32(1), event.ReadyReplicas, "wrong ready replicas")
+
+	go watcher.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusGone, Reason: metav1.StatusReasonExpired, Message: "too old resource version"})
+
+	var failure httpapi.ErrorRes
+	typ = readEvent(t, events, &failure)
+	assert.Equal(t, "error", typ, "watch failure not reported")
+	assert.Equal(t, errors.Unavailable, failure.Code, fmt.Sprintf("expected code %s got %s", errors.Unavailable, failure.Code))
+
+	_, err := events.ReadString('\n')
+	assert.Equal(t, io.EOF, err, "stream not closed after the error event")
+}
+
+// readEvent reads the next server-sent event, decoding its data into v and
+// returning its type.
+func readEvent(t *testing.T, r *bufio.Reader, v interface{}) string {
+	var typ string
+	for {
+		line, err := r.ReadString('\n')
+		require.Nil(t, err, fmt.Sprintf("unexpected error reading event: %s", err))
+
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			return typ
+		case strings.HasPrefix(line, "event: "):
+			typ = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			require.Nil(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), v), "malformed event data")
+		}
+	}
+}
+
+func TestLogsRoute(t *testing.T) {
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "mnist-0", Namespace: namespace, Labels: map[string]string{"app": name}},
+		Spec:       apiv1.PodSpec{Containers: []apiv1.Container{{Name: name}}},
+	}
+	h := mocks.NewHarness(namespace, pod)
+
+	server, token := newServer(t, h)
+	defer server.Close()
+
+	cases := map[string]struct {
+		url    string
+		log    io.Reader
+		status int
+		lines  []httpapi.LogLineRes
+		err    errors.Code
+	}{
+		"stream logs of unknown deployment": {
+			url:    "/deployment/unknown/logs",
+			status: http.StatusNotFound,
+		},
+		"stream logs with malformed tail lines": {
+			url:    "/deployment/mnist/logs?tailLines=ten",
+			status: http.StatusBadRequest,
+		},
+		"stream logs": {
+			url:    "/deployment/mnist/logs",
+			log:    strings.NewReader("2020-04-01T10:00:00Z epoch 1\n2020-04-01T10:00:01Z epoch 2\n"),
+			status: http.StatusOK,
+			lines: []httpapi.LogLineRes{
+				{Pod: "mnist-0", Container: name, Timestamp: "2020-04-01T10:00:00Z", Message: "epoch 1"},
+				{Pod: "mnist-0", Container: name, Timestamp: "2020-04-01T10:00:01Z", Message: "epoch 2"},
+			},
+		},
+		"stream logs cut off by the api server": {
+			url:    "/job/mnist/logs",
+			log:    io.MultiReader(strings.NewReader("2020-04-01T10:00:00Z epoch 1\n"), brokenReader{}),
+			status: http.StatusOK,
+			lines:  []httpapi.LogLineRes{{Pod: "mnist-0", Container: name, Timestamp: "2020-04-01T10:00:00Z", Message: "epoch 1"}},
+			err:    errors.Unavailable,
+		},
+	}
+
+	for desc, tc := range cases {
+		h.Logs["mnist-0"] = tc.log
+
+		res := request(t, http.MethodGet, server.URL+tc.url, token, "")
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status %d got %d", desc, tc.status, res.StatusCode))
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			continue
+		}
+		assert.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"), fmt.Sprintf("%s: wrong content type", desc))
+
+		// Every line but a closing ErrorRes is a LogLineRes.
+		var lines []httpapi.LogLineRes
+		var failure httpapi.ErrorRes
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			if len(lines) == len(tc.lines) {
+				require.Nil(t, json.Unmarshal(scanner.Bytes(), &failure), fmt.Sprintf("%s: malformed error line", desc))
+				continue
+			}
+			var line httpapi.LogLineRes
+			require.Nil(t, json.Unmarshal(scanner.Bytes(), &line), fmt.Sprintf("%s: malformed log line", desc))
+			lines = append(lines, line)
+		}
+		res.Body.Close()
+
+		assert.Equal(t, tc.lines, lines, fmt.Sprintf("%s: wrong lines", desc))
+		assert.Equal(t, tc.err, failure.Code, fmt.Sprintf("%s: expected error code %q got %q", desc, tc.err, failure.Code))
+	}
+}
+
+// brokenReader fails like a log stream cut off by the API server.
+type brokenReader struct{}
+
+func (brokenReader) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("connection reset by peer")
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"github.com/hykuan/k8s-client-example/k8s-client"
 	log "github.com/hykuan/k8s-client-example/logger"
@@ -19,7 +20,21 @@ func LoggingMiddleware(svc k8s_client.Service, logger log.Logger) k8s_client.Ser
 	return &loggingMiddleware{logger, svc}
 }
 
-func (lm *loggingMiddleware) CreateNFSPV(nfsPV k8s_client.NFSPersistentVolume) (name string, err error) {
+func (lm *loggingMiddleware) CreatePV(ctx context.Context, pv k8s_client.PersistentVolume, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_pv for pv %s took %s to complete", pv.Name, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.CreatePV(ctx, pv, opts)
+}
+
+func (lm *loggingMiddleware) CreateNFSPV(ctx context.Context, nfsPV k8s_client.NFSPersistentVolume, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method register for user %+v took %s to complete", nfsPV, time.Since(begin))
 		if err != nil {
@@ -30,10 +45,10 @@ func (lm *loggingMiddleware) CreateNFSPV(nfsPV k8s_client.NFSPersistentVolume) (
 
 	}(time.Now())
 
-	return lm.svc.CreateNFSPV(nfsPV)
+	return lm.svc.CreateNFSPV(ctx, nfsPV, opts)
 }
 
-func (lm *loggingMiddleware) CreatePVC(pvc k8s_client.PersistentVolumeClaim) (name string, err error) {
+func (lm *loggingMiddleware) CreatePVC(ctx context.Context, pvc k8s_client.PersistentVolumeClaim, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method register for user %+v took %s to complete", pvc, time.Since(begin))
 		if err != nil {
@@ -44,10 +59,10 @@ func (lm *loggingMiddleware) CreatePVC(pvc k8s_client.PersistentVolumeClaim) (na
 
 	}(time.Now())
 
-	return lm.svc.CreatePVC(pvc)
+	return lm.svc.CreatePVC(ctx, pvc, opts)
 }
 
-func (lm *loggingMiddleware) CreateDeployment(deployment k8s_client.Deployment) (name string, err error) {
+func (lm *loggingMiddleware) CreateDeployment(ctx context.Context, deployment k8s_client.Deployment, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method register for user %+v took %s to complete", deployment, time.Since(begin))
 		if err != nil {
@@ -58,5 +73,495 @@ func (lm *loggingMiddleware) CreateDeployment(deployment k8s_client.Deployment)
 
 	}(time.Now())
 
-	return lm.svc.CreateDeployment(deployment)
+	return lm.svc.CreateDeployment(ctx, deployment, opts)
+}
+
+func (lm *loggingMiddleware) GetPV(ctx context.Context, name string) (pv k8s_client.PersistentVolumeStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_pv for pv %s took %s to complete", name, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.GetPV(ctx, name)
+}
+
+func (lm *loggingMiddleware) ListPVs(ctx context.Context) (pvs []k8s_client.PersistentVolumeStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_pvs took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.ListPVs(ctx)
+}
+
+func (lm *loggingMiddleware) GetPVC(ctx context.Context, namespace, name string) (pvc k8s_client.PersistentVolumeClaimStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_pvc for pvc %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.GetPVC(ctx, namespace, name)
+}
+
+func (lm *loggingMiddleware) ListPVCs(ctx context.Context, namespace string) (pvcs []k8s_client.PersistentVolumeClaimStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_pvcs in namespace %s took %s to complete", namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.ListPVCs(ctx, namespace)
+}
+
+func (lm *loggingMiddleware) GetDeployment(ctx context.Context, namespace, name string) (deployment k8s_client.DeploymentStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_deployment for deployment %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.GetDeployment(ctx, namespace, name)
+}
+
+func (lm *loggingMiddleware) ListDeployments(ctx context.Context, namespace string) (deployments []k8s_client.DeploymentStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_deployments in namespace %s took %s to complete", namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.ListDeployments(ctx, namespace)
+}
+
+func (lm *loggingMiddleware) DeletePV(ctx context.Context, name string, opts k8s_client.DeleteOptions) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method delete_pv for pv %s took %s to complete", name, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.DeletePV(ctx, name, opts)
+}
+
+func (lm *loggingMiddleware) DeletePVC(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method delete_pvc for pvc %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.DeletePVC(ctx, namespace, name, opts)
+}
+
+func (lm *loggingMiddleware) DeleteDeployment(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method delete_deployment for deployment %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.DeleteDeployment(ctx, namespace, name, opts)
+}
+
+func (lm *loggingMiddleware) UpdateDeployment(ctx context.Context, deployment k8s_client.Deployment) (name string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_deployment for deployment %+v took %s to complete", deployment, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.UpdateDeployment(ctx, deployment)
+}
+
+func (lm *loggingMiddleware) ScaleDeployment(ctx context.Context, namespace, name string, replicas int32) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method scale_deployment for deployment %s in namespace %s to %d replicas took %s to complete", name, namespace, replicas, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.ScaleDeployment(ctx, namespace, name, replicas)
+}
+
+func (lm *loggingMiddleware) CreateJob(ctx context.Context, job k8s_client.Job, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_job for job %+v took %s to complete", job, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.CreateJob(ctx, job, opts)
+}
+
+func (lm *loggingMiddleware) WatchDeployment(ctx context.Context, namespace, name string) (events <-chan k8s_client.WorkloadEvent, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method watch_deployment for deployment %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.WatchDeployment(ctx, namespace, name)
+}
+
+func (lm *loggingMiddleware) WatchJob(ctx context.Context, namespace, name string) (events <-chan k8s_client.WorkloadEvent, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method watch_job for job %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.WatchJob(ctx, namespace, name)
+}
+
+func (lm *loggingMiddleware) StreamLogs(ctx context.Context, namespace, name string, opts k8s_client.LogOptions) (lines <-chan k8s_client.LogLine, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method stream_logs for workload %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.StreamLogs(ctx, namespace, name, opts)
+}
+
+func (lm *loggingMiddleware) GetNodeMetrics(ctx context.Context) (nodes []k8s_client.NodeMetrics, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_node_metrics took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.GetNodeMetrics(ctx)
+}
+
+func (lm *loggingMiddleware) GetPodMetrics(ctx context.Context, namespace, name string) (pods []k8s_client.PodMetrics, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_pod_metrics for workload %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.GetPodMetrics(ctx, namespace, name)
+}
+
+func (lm *loggingMiddleware) ClusterCapacity(ctx context.Context) (nodes []k8s_client.NodeCapacity, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method cluster_capacity took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.ClusterCapacity(ctx)
+}
+
+func (lm *loggingMiddleware) CanSchedule(ctx context.Context, deployment k8s_client.Deployment) (result k8s_client.ScheduleResult, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method can_schedule for deployment %s took %s to complete", deployment.Name, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.CanSchedule(ctx, deployment)
+}
+
+func (lm *loggingMiddleware) CreateRegistryCredential(ctx context.Context, cred k8s_client.RegistryCredential, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_registry_credential %s for %s in namespace %s took %s to complete", cred.Name, cred.Server, cred.Namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.CreateRegistryCredential(ctx, cred, opts)
+}
+
+func (lm *loggingMiddleware) ListRegistryCredentials(ctx context.Context, namespace string) (creds []k8s_client.RegistryCredentialStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_registry_credentials in namespace %s took %s to complete", namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.ListRegistryCredentials(ctx, namespace)
+}
+
+func (lm *loggingMiddleware) DeleteRegistryCredential(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method delete_registry_credential for %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.DeleteRegistryCredential(ctx, namespace, name, opts)
+}
+
+func (lm *loggingMiddleware) ExposeDeployment(ctx context.Context, exposure k8s_client.Exposure) (name string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method expose_deployment for %s in namespace %s took %s to complete", exposure.Name, exposure.Namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.ExposeDeployment(ctx, exposure)
+}
+
+func (lm *loggingMiddleware) CreateConfigMap(ctx context.Context, cm k8s_client.ConfigMap, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_config_map for %s in namespace %s took %s to complete", cm.Name, cm.Namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.CreateConfigMap(ctx, cm, opts)
+}
+
+func (lm *loggingMiddleware) GetConfigMap(ctx context.Context, namespace, name string) (cm k8s_client.ConfigMap, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_config_map for %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.GetConfigMap(ctx, namespace, name)
+}
+
+func (lm *loggingMiddleware) UpdateConfigMap(ctx context.Context, cm k8s_client.ConfigMap) (name string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_config_map for %s in namespace %s took %s to complete", cm.Name, cm.Namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.UpdateConfigMap(ctx, cm)
+}
+
+func (lm *loggingMiddleware) DeleteConfigMap(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method delete_config_map for %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.DeleteConfigMap(ctx, namespace, name, opts)
+}
+
+func (lm *loggingMiddleware) CreateSecret(ctx context.Context, secret k8s_client.Secret, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_secret for %s in namespace %s took %s to complete", secret.Name, secret.Namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.CreateSecret(ctx, secret, opts)
+}
+
+func (lm *loggingMiddleware) GetSecret(ctx context.Context, namespace, name string) (secret k8s_client.SecretStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_secret for %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.GetSecret(ctx, namespace, name)
+}
+
+func (lm *loggingMiddleware) UpdateSecret(ctx context.Context, secret k8s_client.Secret) (name string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_secret for %s in namespace %s took %s to complete", secret.Name, secret.Namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.UpdateSecret(ctx, secret)
+}
+
+func (lm *loggingMiddleware) DeleteSecret(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method delete_secret for %s in namespace %s took %s to complete", name, namespace, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.DeleteSecret(ctx, namespace, name, opts)
+}
+
+func (lm *loggingMiddleware) CreateProject(ctx context.Context, project k8s_client.Project) (name string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_project for %s took %s to complete", project.Name, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.CreateProject(ctx, project)
+}
+
+func (lm *loggingMiddleware) GetProject(ctx context.Context, name string) (project k8s_client.ProjectStatus, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_project for %s took %s to complete", name, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.GetProject(ctx, name)
+}
+
+func (lm *loggingMiddleware) UpdateProject(ctx context.Context, project k8s_client.Project) (name string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_project for %s took %s to complete", project.Name, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.UpdateProject(ctx, project)
+}
+
+func (lm *loggingMiddleware) DeleteProject(ctx context.Context, name string, opts k8s_client.DeleteOptions) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method delete_project for %s took %s to complete", name, time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+
+	}(time.Now())
+
+	return lm.svc.DeleteProject(ctx, name, opts)
 }
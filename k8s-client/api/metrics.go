@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"github.com/hykuan/k8s-client-example/k8s-client"
 	"time"
 
@@ -25,29 +26,353 @@ func MetricsMiddleware(svc k8s_client.Service, counter metrics.Counter, latency
 	}
 }
 
-func (ms *metricsMiddleware) CreateNFSPV(nfsPV k8s_client.NFSPersistentVolume) (name string, err error) {
+func (ms *metricsMiddleware) CreatePV(ctx context.Context, pv k8s_client.PersistentVolume, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_pv").Add(1)
+		ms.latency.With("method", "create_pv").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreatePV(ctx, pv, opts)
+}
+
+func (ms *metricsMiddleware) CreateNFSPV(ctx context.Context, nfsPV k8s_client.NFSPersistentVolume, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "register").Add(1)
 		ms.latency.With("method", "register").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return ms.svc.CreateNFSPV(nfsPV)
+	return ms.svc.CreateNFSPV(ctx, nfsPV, opts)
 }
 
-func (ms *metricsMiddleware) CreatePVC(pvc k8s_client.PersistentVolumeClaim) (name string, err error) {
+func (ms *metricsMiddleware) CreatePVC(ctx context.Context, pvc k8s_client.PersistentVolumeClaim, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "login").Add(1)
 		ms.latency.With("method", "login").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return ms.svc.CreatePVC(pvc)
+	return ms.svc.CreatePVC(ctx, pvc, opts)
 }
 
-func (ms *metricsMiddleware) CreateDeployment(deployment k8s_client.Deployment) (name string, err error) {
+func (ms *metricsMiddleware) CreateDeployment(ctx context.Context, deployment k8s_client.Deployment, opts k8s_client.CreateOptions) (res k8s_client.CreateResult, err error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "login").Add(1)
 		ms.latency.With("method", "login").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return ms.svc.CreateDeployment(deployment)
-}
\ No newline at end of file
+	return ms.svc.CreateDeployment(ctx, deployment, opts)
+}
+
+func (ms *metricsMiddleware) GetPV(ctx context.Context, name string) (k8s_client.PersistentVolumeStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_pv").Add(1)
+		ms.latency.With("method", "get_pv").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetPV(ctx, name)
+}
+
+func (ms *metricsMiddleware) ListPVs(ctx context.Context) ([]k8s_client.PersistentVolumeStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_pvs").Add(1)
+		ms.latency.With("method", "list_pvs").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListPVs(ctx)
+}
+
+func (ms *metricsMiddleware) GetPVC(ctx context.Context, namespace, name string) (k8s_client.PersistentVolumeClaimStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_pvc").Add(1)
+		ms.latency.With("method", "get_pvc").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetPVC(ctx, namespace, name)
+}
+
+func (ms *metricsMiddleware) ListPVCs(ctx context.Context, namespace string) ([]k8s_client.PersistentVolumeClaimStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_pvcs").Add(1)
+		ms.latency.With("method", "list_pvcs").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListPVCs(ctx, namespace)
+}
+
+func (ms *metricsMiddleware) GetDeployment(ctx context.Context, namespace, name string) (k8s_client.DeploymentStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_deployment").Add(1)
+		ms.latency.With("method", "get_deployment").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetDeployment(ctx, namespace, name)
+}
+
+func (ms *metricsMiddleware) ListDeployments(ctx context.Context, namespace string) ([]k8s_client.DeploymentStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_deployments").Add(1)
+		ms.latency.With("method", "list_deployments").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListDeployments(ctx, namespace)
+}
+
+func (ms *metricsMiddleware) DeletePV(ctx context.Context, name string, opts k8s_client.DeleteOptions) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "delete_pv").Add(1)
+		ms.latency.With("method", "delete_pv").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.DeletePV(ctx, name, opts)
+}
+
+func (ms *metricsMiddleware) DeletePVC(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "delete_pvc").Add(1)
+		ms.latency.With("method", "delete_pvc").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.DeletePVC(ctx, namespace, name, opts)
+}
+
+func (ms *metricsMiddleware) DeleteDeployment(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "delete_deployment").Add(1)
+		ms.latency.With("method", "delete_deployment").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.DeleteDeployment(ctx, namespace, name, opts)
+}
+
+func (ms *metricsMiddleware) UpdateDeployment(ctx context.Context, deployment k8s_client.Deployment) (string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_deployment").Add(1)
+		ms.latency.With("method", "update_deployment").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateDeployment(ctx, deployment)
+}
+
+func (ms *metricsMiddleware) ScaleDeployment(ctx context.Context, namespace, name string, replicas int32) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "scale_deployment").Add(1)
+		ms.latency.With("method", "scale_deployment").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ScaleDeployment(ctx, namespace, name, replicas)
+}
+
+func (ms *metricsMiddleware) CreateJob(ctx context.Context, job k8s_client.Job, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_job").Add(1)
+		ms.latency.With("method", "create_job").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateJob(ctx, job, opts)
+}
+
+func (ms *metricsMiddleware) WatchDeployment(ctx context.Context, namespace, name string) (<-chan k8s_client.WorkloadEvent, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "watch_deployment").Add(1)
+		ms.latency.With("method", "watch_deployment").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.WatchDeployment(ctx, namespace, name)
+}
+
+func (ms *metricsMiddleware) WatchJob(ctx context.Context, namespace, name string) (<-chan k8s_client.WorkloadEvent, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "watch_job").Add(1)
+		ms.latency.With("method", "watch_job").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.WatchJob(ctx, namespace, name)
+}
+
+func (ms *metricsMiddleware) StreamLogs(ctx context.Context, namespace, name string, opts k8s_client.LogOptions) (<-chan k8s_client.LogLine, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "stream_logs").Add(1)
+		ms.latency.With("method", "stream_logs").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.StreamLogs(ctx, namespace, name, opts)
+}
+
+func (ms *metricsMiddleware) GetNodeMetrics(ctx context.Context) ([]k8s_client.NodeMetrics, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_node_metrics").Add(1)
+		ms.latency.With("method", "get_node_metrics").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetNodeMetrics(ctx)
+}
+
+func (ms *metricsMiddleware) GetPodMetrics(ctx context.Context, namespace, name string) ([]k8s_client.PodMetrics, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_pod_metrics").Add(1)
+		ms.latency.With("method", "get_pod_metrics").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetPodMetrics(ctx, namespace, name)
+}
+
+func (ms *metricsMiddleware) ClusterCapacity(ctx context.Context) ([]k8s_client.NodeCapacity, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "cluster_capacity").Add(1)
+		ms.latency.With("method", "cluster_capacity").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ClusterCapacity(ctx)
+}
+
+func (ms *metricsMiddleware) CanSchedule(ctx context.Context, deployment k8s_client.Deployment) (k8s_client.ScheduleResult, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "can_schedule").Add(1)
+		ms.latency.With("method", "can_schedule").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CanSchedule(ctx, deployment)
+}
+
+func (ms *metricsMiddleware) CreateRegistryCredential(ctx context.Context, cred k8s_client.RegistryCredential, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_registry_credential").Add(1)
+		ms.latency.With("method", "create_registry_credential").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateRegistryCredential(ctx, cred, opts)
+}
+
+func (ms *metricsMiddleware) ListRegistryCredentials(ctx context.Context, namespace string) ([]k8s_client.RegistryCredentialStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_registry_credentials").Add(1)
+		ms.latency.With("method", "list_registry_credentials").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListRegistryCredentials(ctx, namespace)
+}
+
+func (ms *metricsMiddleware) DeleteRegistryCredential(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "delete_registry_credential").Add(1)
+		ms.latency.With("method", "delete_registry_credential").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.DeleteRegistryCredential(ctx, namespace, name, opts)
+}
+
+func (ms *metricsMiddleware) ExposeDeployment(ctx context.Context, exposure k8s_client.Exposure) (string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "expose_deployment").Add(1)
+		ms.latency.With("method", "expose_deployment").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ExposeDeployment(ctx, exposure)
+}
+
+func (ms *metricsMiddleware) CreateConfigMap(ctx context.Context, cm k8s_client.ConfigMap, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_config_map").Add(1)
+		ms.latency.With("method", "create_config_map").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateConfigMap(ctx, cm, opts)
+}
+
+func (ms *metricsMiddleware) GetConfigMap(ctx context.Context, namespace, name string) (k8s_client.ConfigMap, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_config_map").Add(1)
+		ms.latency.With("method", "get_config_map").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetConfigMap(ctx, namespace, name)
+}
+
+func (ms *metricsMiddleware) UpdateConfigMap(ctx context.Context, cm k8s_client.ConfigMap) (string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_config_map").Add(1)
+		ms.latency.With("method", "update_config_map").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateConfigMap(ctx, cm)
+}
+
+func (ms *metricsMiddleware) DeleteConfigMap(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "delete_config_map").Add(1)
+		ms.latency.With("method", "delete_config_map").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.DeleteConfigMap(ctx, namespace, name, opts)
+}
+
+func (ms *metricsMiddleware) CreateSecret(ctx context.Context, secret k8s_client.Secret, opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_secret").Add(1)
+		ms.latency.With("method", "create_secret").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateSecret(ctx, secret, opts)
+}
+
+func (ms *metricsMiddleware) GetSecret(ctx context.Context, namespace, name string) (k8s_client.SecretStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_secret").Add(1)
+		ms.latency.With("method", "get_secret").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetSecret(ctx, namespace, name)
+}
+
+func (ms *metricsMiddleware) UpdateSecret(ctx context.Context, secret k8s_client.Secret) (string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_secret").Add(1)
+		ms.latency.With("method", "update_secret").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateSecret(ctx, secret)
+}
+
+func (ms *metricsMiddleware) DeleteSecret(ctx context.Context, namespace, name string, opts k8s_client.DeleteOptions) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "delete_secret").Add(1)
+		ms.latency.With("method", "delete_secret").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.DeleteSecret(ctx, namespace, name, opts)
+}
+
+func (ms *metricsMiddleware) CreateProject(ctx context.Context, project k8s_client.Project) (string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_project").Add(1)
+		ms.latency.With("method", "create_project").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateProject(ctx, project)
+}
+
+func (ms *metricsMiddleware) GetProject(ctx context.Context, name string) (k8s_client.ProjectStatus, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_project").Add(1)
+		ms.latency.With("method", "get_project").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetProject(ctx, name)
+}
+
+func (ms *metricsMiddleware) UpdateProject(ctx context.Context, project k8s_client.Project) (string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_project").Add(1)
+		ms.latency.With("method", "update_project").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateProject(ctx, project)
+}
+
+func (ms *metricsMiddleware) DeleteProject(ctx context.Context, name string, opts k8s_client.DeleteOptions) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "delete_project").Add(1)
+		ms.latency.With("method", "delete_project").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.DeleteProject(ctx, name, opts)
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"io/ioutil"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/hykuan/k8s-client-example/auth"
+)
+
+// Verbs a rule may grant.
+const (
+	VerbCreate = "create"
+	VerbRead   = "read"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+)
+
+// Resource kinds a rule may grant access to. PersistentVolumes and nodes are
+// cluster-scoped. Projects are authorized in the namespace they own.
+const (
+	KindPersistentVolumes      = "persistentvolumes"
+	KindPersistentVolumeClaims = "persistentvolumeclaims"
+	KindDeployments            = "deployments"
+	KindJobs                   = "jobs"
+	KindPods                   = "pods"
+	KindServices               = "services"
+	KindIngresses              = "ingresses"
+	KindConfigMaps             = "configmaps"
+	KindSecrets                = "secrets"
+	KindNodes                  = "nodes"
+	KindProjects               = "projects"
+)
+
+// wildcard matches any subject, group, verb, kind or namespace. It is the
+// only namespace that matches cluster-scoped resources.
+const wildcard = "*"
+
+// Policy decides which callers may perform which operations. Requests that
+// no rule allows are denied.
+type Policy struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule allows the callers named by Subjects, or belonging to one of Groups,
+// to perform Verbs on resources of Kinds in Namespaces.
+type Rule struct {
+	Subjects   []string `json:"subjects"`
+	Groups     []string `json:"groups"`
+	Verbs      []string `json:"verbs"`
+	Kinds      []string `json:"kinds"`
+	Namespaces []string `json:"namespaces"`
+}
+
+// LoadPolicy reads a policy from a YAML or JSON file.
+func LoadPolicy(path string) (Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	var p Policy
+	if err := yaml.UnmarshalStrict(data, &p); err != nil {
+		return Policy{}, err
+	}
+
+	return p, nil
+}
+
+// Allows reports whether id may perform verb on resources of kind in
+// namespace, which is empty for cluster-scoped resources.
+func (p Policy) Allows(id auth.Identity, verb, kind, namespace string) bool {
+	for _, r := range p.Rules {
+		if r.appliesTo(id) && matches(r.Verbs, verb) && matches(r.Kinds, kind) && matches(r.Namespaces, namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) appliesTo(id auth.Identity) bool {
+	if matches(r.Subjects, id.Subject) {
+		return true
+	}
+
+	for _, g := range id.Groups {
+		if matches(r.Groups, g) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matches(values []string, value string) bool {
+	for _, v := range values {
+		if v == wildcard || (v == value && value != "") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,417 @@
+package k8s_client
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	jobv1 "k8s.io/api/batch/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+)
+
+// CreateOptions controls what the Create methods do when an object of the
+// same name already exists. By default they fail with ErrConflict. With Apply
+// set, the existing object is updated to match the requested spec instead, so
+// that re-submitting the same spec is a no-op and a changed spec converges.
+//
+// With DryRun set nothing is persisted, and the Create methods also return the
+// rendered manifest, formatted as Output. The manifests of secrets don't
+// include their data.
+type CreateOptions struct {
+	Apply  bool
+	DryRun string
+	Output string
+}
+
+func (opts CreateOptions) Validate() error {
+	if !dryRunModes[opts.DryRun] || !outputFormats[opts.Output] {
+		return ErrMalformedEntity
+	}
+
+	if opts.Apply && opts.DryRun != "" {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// CreateResult is the outcome of a Create method. Manifest is only set by dry
+// runs.
+type CreateResult struct {
+	Name     string
+	Manifest string
+}
+
+// create runs create, or the dry run requested by opts, for obj. The dry run
+// goes through client, since the typed clients of this client-go version
+// can't pass create options; resource is the plural name of obj's kind.
+func (svc k8sClientService) create(ctx context.Context, opts CreateOptions, client rest.Interface, resource, namespace string, obj runtime.Object, create func() error, update func() error) (CreateResult, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return CreateResult{}, err
+	}
+	res := CreateResult{Name: accessor.GetName()}
+
+	switch opts.DryRun {
+	case DryRunLocal:
+		res.Manifest, err = render(obj, namespace, opts.Output)
+		return res, err
+	case DryRunServer:
+		result := obj.DeepCopyObject()
+		err = client.Post().
+			Context(ctx).
+			Namespace(namespace).
+			Resource(resource).
+			VersionedParams(&metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}, scheme.ParameterCodec).
+			Body(obj).
+			Do().
+			Into(result)
+		if err != nil {
+			return CreateResult{}, translateError(err)
+		}
+
+		res.Manifest, err = render(result, namespace, opts.Output)
+		return res, err
+	}
+
+	if err := apply(opts, create, update); err != nil {
+		return CreateResult{}, err
+	}
+
+	return res, nil
+}
+
+// apply calls create and, when the object already exists and opts.Apply is
+// set, update until it no longer conflicts with concurrent writers.
+func apply(opts CreateOptions, create func() error, update func() error) error {
+	err := create()
+	if opts.Apply && k8sErrors.IsAlreadyExists(err) {
+		err = retry.RetryOnConflict(retry.DefaultRetry, update)
+	}
+
+	return translateError(err)
+}
+
+// applyPV converges the capacity, access modes, storage class and labels of a
+// volume. Its source can't be changed once it's created.
+func (svc k8sClientService) applyPV(desired *apiv1.PersistentVolume) func() error {
+	return func() error {
+		pv, err := svc.pvClient.Get(desired.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		source := desired.Spec.PersistentVolumeSource.DeepCopy()
+		if source.ISCSI != nil && source.ISCSI.ISCSIInterface == "" {
+			source.ISCSI.ISCSIInterface = defaultISCSIInterface
+		}
+		if !equality.Semantic.DeepEqual(pv.Spec.PersistentVolumeSource, *source) ||
+			!equality.Semantic.DeepEqual(pv.Spec.NodeAffinity, desired.Spec.NodeAffinity) {
+			return immutableFieldError("source")
+		}
+
+		merged := pv.DeepCopy()
+		merged.Spec.Capacity = desired.Spec.Capacity
+		merged.Spec.AccessModes = desired.Spec.AccessModes
+		merged.Spec.StorageClassName = desired.Spec.StorageClassName
+		for key, value := range desired.Labels {
+			if merged.Labels == nil {
+				merged.Labels = map[string]string{}
+			}
+			merged.Labels[key] = value
+		}
+		if equality.Semantic.DeepEqual(pv, merged) {
+			return nil
+		}
+
+		_, err = svc.pvClient.Update(merged)
+		return err
+	}
+}
+
+// applyPVC expands the storage request of a claim. The rest of a claim's
+// spec can't be changed once it's created.
+func (svc k8sClientService) applyPVC(namespace string, desired *apiv1.PersistentVolumeClaim) func() error {
+	client := svc.pvcClient(namespace)
+
+	return func() error {
+		pvc, err := client.Get(desired.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if field := changedClaimField(pvc, desired); field != "" {
+			return immutableFieldError(field)
+		}
+
+		storage := pvc.Spec.Resources.Requests[apiv1.ResourceStorage]
+		if storage.Cmp(desired.Spec.Resources.Requests[apiv1.ResourceStorage]) > 0 {
+			return &FieldError{Field: "storage", Reason: "can't be decreased"}
+		}
+
+		merged := pvc.DeepCopy()
+		merged.Spec.Resources.Requests = desired.Spec.Resources.Requests
+		if equality.Semantic.DeepEqual(pvc, merged) {
+			return nil
+		}
+
+		_, err = client.Update(merged)
+		return err
+	}
+}
+
+// applyDeployment converges the replicas and the pod template fields set by
+// CreateDeployment, leaving defaults filled in by the API server alone.
+func (svc k8sClientService) applyDeployment(namespace string, desired *appsv1.Deployment) func() error {
+	client := svc.deploymentsClient(namespace)
+
+	return func() error {
+		d, err := client.Get(desired.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		merged := d.DeepCopy()
+		merged.Spec.Replicas = desired.Spec.Replicas
+		mergePodSpec(&merged.Spec.Template.Spec, desired.Spec.Template.Spec, desired.Name)
+		if equality.Semantic.DeepEqual(d, merged) {
+			return nil
+		}
+
+		_, err = client.Update(merged)
+		return err
+	}
+}
+
+// applyJob converges the parallelism, deadline, backoff limit and TTL of a
+// job. Its completions and pod template can't be changed once it's created.
+func (svc k8sClientService) applyJob(namespace string, desired *jobv1.Job) func() error {
+	client := svc.jobsClient(namespace)
+
+	return func() error {
+		j, err := client.Get(desired.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if field := changedJobField(j, desired); field != "" {
+			return immutableFieldError(field)
+		}
+
+		merged := j.DeepCopy()
+		if desired.Spec.Parallelism != nil {
+			merged.Spec.Parallelism = desired.Spec.Parallelism
+		}
+		if desired.Spec.ActiveDeadlineSeconds != nil {
+			merged.Spec.ActiveDeadlineSeconds = desired.Spec.ActiveDeadlineSeconds
+		}
+		if desired.Spec.BackoffLimit != nil {
+			merged.Spec.BackoffLimit = desired.Spec.BackoffLimit
+		}
+		if desired.Spec.TTLSecondsAfterFinished != nil {
+			merged.Spec.TTLSecondsAfterFinished = desired.Spec.TTLSecondsAfterFinished
+		}
+		if equality.Semantic.DeepEqual(j, merged) {
+			return nil
+		}
+
+		_, err = client.Update(merged)
+		return err
+	}
+}
+
+// applySecret replaces the data of an existing secret of the same type.
+func (svc k8sClientService) applySecret(namespace string, desired *apiv1.Secret) func() error {
+	client := svc.secretsClient(namespace)
+
+	return func() error {
+		secret, err := client.Get(desired.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if desired.Type != "" && desired.Type != secret.Type {
+			return immutableFieldError("type")
+		}
+
+		merged := secret.DeepCopy()
+		merged.Data = desired.Data
+		if equality.Semantic.DeepEqual(secret, merged) {
+			return nil
+		}
+
+		_, err = client.Update(merged)
+		return err
+	}
+}
+
+// applyConfigMap replaces the data of an existing config map.
+func (svc k8sClientService) applyConfigMap(namespace string, desired *apiv1.ConfigMap) func() error {
+	client := svc.configMapsClient(namespace)
+
+	return func() error {
+		cm, err := client.Get(desired.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		merged := cm.DeepCopy()
+		merged.Data = desired.Data
+		if equality.Semantic.DeepEqual(cm, merged) {
+			return nil
+		}
+
+		_, err = client.Update(merged)
+		return err
+	}
+}
+
+// mergePodSpec copies the fields rendered from a workload spec into an
+// existing pod spec, updating the container named name.
+func mergePodSpec(spec *apiv1.PodSpec, desired apiv1.PodSpec, name string) {
+	if len(spec.Containers) == 0 || len(desired.Containers) == 0 {
+		spec.Containers = desired.Containers
+	} else {
+		container := &spec.Containers[0]
+		for i := range spec.Containers {
+			if spec.Containers[i].Name == name {
+				container = &spec.Containers[i]
+				break
+			}
+		}
+
+		want := desired.Containers[0]
+		container.Image = want.Image
+		container.Command = want.Command
+		container.Args = want.Args
+		container.Resources = want.Resources
+		container.VolumeMounts = want.VolumeMounts
+		container.Env = want.Env
+		container.EnvFrom = want.EnvFrom
+		container.Ports = want.Ports
+	}
+
+	spec.Volumes = desired.Volumes
+	spec.ImagePullSecrets = desired.ImagePullSecrets
+	spec.NodeSelector = desired.NodeSelector
+	spec.Tolerations = desired.Tolerations
+	spec.Affinity = desired.Affinity
+	spec.PriorityClassName = desired.PriorityClassName
+	if desired.SchedulerName != "" {
+		spec.SchedulerName = desired.SchedulerName
+	}
+}
+
+// defaultISCSIInterface is the interface the API server sets on iSCSI volumes
+// that don't name one.
+const defaultISCSIInterface = "default"
+
+func immutableFieldError(field string) error {
+	return &FieldError{Field: field, Reason: "can't be changed"}
+}
+
+// changedClaimField names the first field set by CreatePVC that differs
+// between an existing claim and the desired one, ignoring the storage class
+// and volume a claim may be given by the cluster when it doesn't ask for them.
+func changedClaimField(pvc, desired *apiv1.PersistentVolumeClaim) string {
+	have, want := pvc.Spec, desired.Spec
+
+	switch {
+	case !equality.Semantic.DeepEqual(have.AccessModes, want.AccessModes):
+		return "accessModes"
+	case want.StorageClassName != nil && !equality.Semantic.DeepEqual(have.StorageClassName, want.StorageClassName):
+		return "storageClassName"
+	case have.VolumeMode != nil && want.VolumeMode != nil && *have.VolumeMode != *want.VolumeMode:
+		return "volumeMode"
+	case want.VolumeName != "" && have.VolumeName != want.VolumeName:
+		return "volumeName"
+	case !equality.Semantic.DeepEqual(have.Selector, want.Selector):
+		return "selector"
+	}
+
+	return ""
+}
+
+// changedJobField names the first field set by CreateJob that differs between
+// an existing job and the desired one and can't be updated.
+func changedJobField(j, desired *jobv1.Job) string {
+	if desired.Spec.Completions != nil && !equality.Semantic.DeepEqual(j.Spec.Completions, desired.Spec.Completions) {
+		return "completions"
+	}
+
+	// Comparing the spec merged into the existing one leaves the defaults set
+	// by the API server out of the comparison.
+	have := j.Spec.Template.Spec
+	want := *have.DeepCopy()
+	mergePodSpec(&want, desired.Spec.Template.Spec, desired.Name)
+
+	if len(have.Containers) != len(want.Containers) {
+		return "image"
+	}
+
+	for i := range have.Containers {
+		h, w := have.Containers[i], want.Containers[i]
+		fields := []struct {
+			name       string
+			have, want interface{}
+		}{
+			{"image", h.Image, w.Image},
+			{"command", h.Command, w.Command},
+			{"arguments", h.Args, w.Args},
+			{"resource", h.Resources, w.Resources},
+			{"volumes", h.VolumeMounts, w.VolumeMounts},
+			{"env", h.Env, w.Env},
+			{"envFrom", h.EnvFrom, w.EnvFrom},
+		}
+		for _, f := range fields {
+			if !equality.Semantic.DeepEqual(f.have, f.want) {
+				return f.name
+			}
+		}
+	}
+
+	fields := []struct {
+		name       string
+		have, want interface{}
+	}{
+		{"volumes", withoutDefaultModes(have.Volumes), withoutDefaultModes(want.Volumes)},
+		{"imagePullSecrets", have.ImagePullSecrets, want.ImagePullSecrets},
+		{"nodeSelector", have.NodeSelector, want.NodeSelector},
+		{"tolerations", have.Tolerations, want.Tolerations},
+		{"affinity", have.Affinity, want.Affinity},
+		{"priorityClassName", have.PriorityClassName, want.PriorityClassName},
+		{"schedulerName", have.SchedulerName, want.SchedulerName},
+	}
+	for _, f := range fields {
+		if !equality.Semantic.DeepEqual(f.have, f.want) {
+			return f.name
+		}
+	}
+
+	return ""
+}
+
+// withoutDefaultModes clears the file modes the API server sets on secret and
+// config map volumes, which CreateJob never does.
+func withoutDefaultModes(volumes []apiv1.Volume) []apiv1.Volume {
+	var res []apiv1.Volume
+	for _, v := range volumes {
+		v := *v.DeepCopy()
+		if v.Secret != nil {
+			v.Secret.DefaultMode = nil
+		}
+		if v.ConfigMap != nil {
+			v.ConfigMap.DefaultMode = nil
+		}
+		res = append(res, v)
+	}
+
+	return res
+}
@@ -0,0 +1,283 @@
+package k8s_client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const gpuResource apiv1.ResourceName = "nvidia.com/gpu"
+
+// gpuModelLabels are the node labels, in order of preference, that carry the
+// GPU model as published by NVIDIA GPU feature discovery and cloud providers.
+var gpuModelLabels = []string{
+	"nvidia.com/gpu.product",
+	"cloud.google.com/gke-accelerator",
+	"accelerator",
+}
+
+// ResourceAmounts holds CPU, memory and GPU quantities in Kubernetes notation.
+type ResourceAmounts struct {
+	CPU    string
+	Memory string
+	GPU    string
+}
+
+// NodeCapacity compares what a node can offer to pods with what the pods
+// currently running on it have requested.
+type NodeCapacity struct {
+	Name        string
+	GPUModel    string
+	Schedulable bool
+	Allocatable ResourceAmounts
+	Requested   ResourceAmounts
+}
+
+// ScheduleResult tells whether all replicas of a workload fit on the cluster
+// right now and, if so, on which nodes at least one of them does.
+type ScheduleResult struct {
+	Schedulable bool
+	Nodes       []string
+	Reason      string
+}
+
+type nodeUsage struct {
+	node      apiv1.Node
+	requested apiv1.ResourceList
+}
+
+func (svc k8sClientService) ClusterCapacity(ctx context.Context) ([]NodeCapacity, error) {
+	usages, err := svc.nodeUsages()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []NodeCapacity{}
+	for _, u := range usages {
+		nodes = append(nodes, NodeCapacity{
+			Name:        u.node.Name,
+			GPUModel:    gpuModel(u.node),
+			Schedulable: schedulable(u.node),
+			Allocatable: toResourceAmounts(u.node.Status.Allocatable),
+			Requested:   toResourceAmounts(u.requested),
+		})
+	}
+
+	return nodes, nil
+}
+
+func (svc k8sClientService) CanSchedule(ctx context.Context, deployment Deployment) (ScheduleResult, error) {
+	usages, err := svc.nodeUsages()
+	if err != nil {
+		return ScheduleResult{}, err
+	}
+
+	replicas := deployment.Replicas
+	if replicas <= 0 {
+		replicas = defaultReplicas
+	}
+
+	want := podRequests(apiv1.PodSpec{Containers: []apiv1.Container{{Resources: deployment.GetResources()}}})
+	result := ScheduleResult{Nodes: []string{}}
+	insufficient := map[apiv1.ResourceName]bool{}
+	candidates := 0
+	var fitting int32
+
+	for _, u := range usages {
+		if !available(u.node) || !admits(u.node, deployment.NodeSelector, deployment.GetTolerations(), deployment.GetAffinity()) {
+			continue
+		}
+		candidates++
+
+		if n := replicasFitting(u, want, replicas); n > 0 {
+			result.Nodes = append(result.Nodes, u.node.Name)
+			fitting += n
+			continue
+		}
+
+		for _, name := range missingResources(u, want) {
+			insufficient[name] = true
+		}
+	}
+
+	switch {
+	case fitting >= replicas:
+		result.Schedulable = true
+	case fitting > 0:
+		result.Reason = fmt.Sprintf("only %d of %d replicas fit on the %d schedulable nodes", fitting, replicas, candidates)
+	case candidates == 0:
+		result.Reason = "no schedulable nodes match the node selector, tolerations and affinity"
+	default:
+		var names []string
+		for name := range insufficient {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		result.Reason = fmt.Sprintf("insufficient %s on all %d schedulable nodes", strings.Join(names, ", "), candidates)
+	}
+
+	return result, nil
+}
+
+// nodeUsages sums the resource requests of all non-terminated pods per node.
+func (svc k8sClientService) nodeUsages() ([]nodeUsage, error) {
+	nodes, err := svc.clientSet.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	pods, err := svc.clientSet.CoreV1().Pods(metav1.NamespaceAll).List(metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	requested := map[string]apiv1.ResourceList{}
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+
+		list, ok := requested[pod.Spec.NodeName]
+		if !ok {
+			list = apiv1.ResourceList{}
+			requested[pod.Spec.NodeName] = list
+		}
+
+		addResources(list, podRequests(pod.Spec))
+	}
+
+	var usages []nodeUsage
+	for _, node := range nodes.Items {
+		list, ok := requested[node.Name]
+		if !ok {
+			list = apiv1.ResourceList{}
+		}
+		usages = append(usages, nodeUsage{node: node, requested: list})
+	}
+
+	return usages, nil
+}
+
+// containerRequests returns the container requests, defaulting each resource
+// that only has a limit to that limit, as the API server does.
+func containerRequests(c apiv1.Container) apiv1.ResourceList {
+	list := apiv1.ResourceList{}
+	for name, q := range c.Resources.Limits {
+		list[name] = q
+	}
+	for name, q := range c.Resources.Requests {
+		list[name] = q
+	}
+
+	return list
+}
+
+// podRequests returns the effective requests of a pod: the sum over its
+// containers, or the largest init container request where that is higher,
+// since init containers run one at a time before the others.
+func podRequests(spec apiv1.PodSpec) apiv1.ResourceList {
+	total := apiv1.ResourceList{}
+	for _, c := range spec.Containers {
+		addResources(total, containerRequests(c))
+	}
+
+	for _, c := range spec.InitContainers {
+		for name, q := range containerRequests(c) {
+			if q.Cmp(total[name]) > 0 {
+				total[name] = q
+			}
+		}
+	}
+
+	return total
+}
+
+func addResources(total, list apiv1.ResourceList) {
+	for name, q := range list {
+		sum := total[name]
+		sum.Add(q)
+		total[name] = sum
+	}
+}
+
+// replicasFitting returns how many pods requesting want fit in the free
+// resources of a node, up to max.
+func replicasFitting(u nodeUsage, want apiv1.ResourceList, max int32) int32 {
+	fitting := int64(max)
+	for name, q := range want {
+		if q.IsZero() {
+			continue
+		}
+
+		free := u.node.Status.Allocatable[name]
+		free.Sub(u.requested[name])
+		if free.Sign() <= 0 {
+			return 0
+		}
+
+		if n := free.MilliValue() / q.MilliValue(); n < fitting {
+			fitting = n
+		}
+	}
+
+	return int32(fitting)
+}
+
+func missingResources(u nodeUsage, want apiv1.ResourceList) []apiv1.ResourceName {
+	var missing []apiv1.ResourceName
+	for name, q := range want {
+		free := u.node.Status.Allocatable[name]
+		free.Sub(u.requested[name])
+		if q.Cmp(free) > 0 {
+			missing = append(missing, name)
+		}
+	}
+
+	return missing
+}
+
+// schedulable reports whether pods without tolerations can be placed on node.
+func schedulable(node apiv1.Node) bool {
+	return available(node) && admits(node, nil, nil, nil)
+}
+
+// available reports whether node is ready and not cordoned.
+func available(node apiv1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+
+	for _, c := range node.Status.Conditions {
+		if c.Type == apiv1.NodeReady {
+			return c.Status == apiv1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func gpuModel(node apiv1.Node) string {
+	for _, label := range gpuModelLabels {
+		if model, ok := node.Labels[label]; ok {
+			return model
+		}
+	}
+
+	return ""
+}
+
+func toResourceAmounts(list apiv1.ResourceList) ResourceAmounts {
+	cpu, memory, gpu := list[apiv1.ResourceCPU], list[apiv1.ResourceMemory], list[gpuResource]
+
+	return ResourceAmounts{
+		CPU:    cpu.String(),
+		Memory: memory.String(),
+		GPU:    gpu.String(),
+	}
+}
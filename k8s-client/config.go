@@ -0,0 +1,204 @@
+package k8s_client
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// ConfigMap holds non-confidential configuration that workloads consume as
+// environment variables or mounted files.
+type ConfigMap struct {
+	Name      string
+	Namespace string
+	Data      map[string]string
+}
+
+func (cm ConfigMap) Validate() error {
+	if cm.Name == "" {
+		return ErrMalformedEntity
+	}
+
+	return validateKeys(cm.Data)
+}
+
+// Secret holds confidential configuration. An empty Type means Opaque. Its
+// values are write-only: they can be set but are never read back.
+type Secret struct {
+	Name      string
+	Namespace string
+	Type      string
+	Data      map[string]string
+}
+
+func (s Secret) Validate() error {
+	if s.Name == "" {
+		return ErrMalformedEntity
+	}
+
+	return validateKeys(s.Data)
+}
+
+// SecretStatus describes a stored secret by the keys it holds, without their
+// values.
+type SecretStatus struct {
+	Name      string
+	Namespace string
+	Type      string
+	Keys      []string
+}
+
+func (svc k8sClientService) configMapsClient(namespace string) corev1.ConfigMapInterface {
+	return svc.clientSet.CoreV1().ConfigMaps(svc.namespace(namespace))
+}
+
+func (svc k8sClientService) CreateConfigMap(ctx context.Context, cm ConfigMap, opts CreateOptions) (CreateResult, error) {
+	configMap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cm.Name,
+		},
+		Data: cm.Data,
+	}
+
+	create := func() error {
+		_, err := svc.configMapsClient(cm.Namespace).Create(configMap)
+		return err
+	}
+
+	return svc.create(ctx, opts, svc.clientSet.CoreV1().RESTClient(), "configmaps", svc.namespace(cm.Namespace), configMap, create, svc.applyConfigMap(cm.Namespace, configMap))
+}
+
+func (svc k8sClientService) GetConfigMap(ctx context.Context, namespace, name string) (ConfigMap, error) {
+	configMap, err := svc.configMapsClient(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return ConfigMap{}, translateError(err)
+	}
+
+	data := configMap.Data
+	if data == nil {
+		data = map[string]string{}
+	}
+
+	return ConfigMap{Name: configMap.Name, Namespace: configMap.Namespace, Data: data}, nil
+}
+
+// UpdateConfigMap replaces the data of an existing config map.
+func (svc k8sClientService) UpdateConfigMap(ctx context.Context, cm ConfigMap) (string, error) {
+	desired := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cm.Name,
+		},
+		Data: cm.Data,
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, svc.applyConfigMap(cm.Namespace, desired)); err != nil {
+		return "", translateError(err)
+	}
+
+	return cm.Name, nil
+}
+
+func (svc k8sClientService) DeleteConfigMap(ctx context.Context, namespace, name string, opts DeleteOptions) error {
+	return translateError(svc.configMapsClient(namespace).Delete(name, opts.toDeleteOptions()))
+}
+
+func (svc k8sClientService) CreateSecret(ctx context.Context, s Secret, opts CreateOptions) (CreateResult, error) {
+	secretType := apiv1.SecretTypeOpaque
+	if s.Type != "" {
+		secretType = apiv1.SecretType(s.Type)
+	}
+
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: s.Name,
+		},
+		Type: secretType,
+		Data: secretData(s.Data),
+	}
+
+	create := func() error {
+		_, err := svc.secretsClient(s.Namespace).Create(secret)
+		return err
+	}
+
+	return svc.create(ctx, opts, svc.clientSet.CoreV1().RESTClient(), "secrets", svc.namespace(s.Namespace), secret, create, svc.applySecret(s.Namespace, secret))
+}
+
+func (svc k8sClientService) GetSecret(ctx context.Context, namespace, name string) (SecretStatus, error) {
+	secret, err := svc.secretsClient(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return SecretStatus{}, translateError(err)
+	}
+
+	return toSecretStatus(*secret), nil
+}
+
+// UpdateSecret replaces the data of an existing secret. The type of a secret
+// can't be changed, so a different non-empty Type is rejected.
+func (svc k8sClientService) UpdateSecret(ctx context.Context, s Secret) (string, error) {
+	desired := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: s.Name,
+		},
+		Type: apiv1.SecretType(s.Type),
+		Data: secretData(s.Data),
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, svc.applySecret(s.Namespace, desired)); err != nil {
+		return "", translateError(err)
+	}
+
+	return s.Name, nil
+}
+
+func (svc k8sClientService) DeleteSecret(ctx context.Context, namespace, name string, opts DeleteOptions) error {
+	return translateError(svc.secretsClient(namespace).Delete(name, opts.toDeleteOptions()))
+}
+
+func secretData(data map[string]string) map[string][]byte {
+	res := map[string][]byte{}
+	for key, value := range data {
+		res[key] = []byte(value)
+	}
+
+	return res
+}
+
+func toSecretStatus(secret apiv1.Secret) SecretStatus {
+	keys := []string{}
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return SecretStatus{
+		Name:      secret.Name,
+		Namespace: secret.Namespace,
+		Type:      string(secret.Type),
+		Keys:      keys,
+	}
+}
+
+// validateKeys checks that data keys are valid file names, since config maps
+// and secrets may be mounted as volumes.
+func validateKeys(data map[string]string) error {
+	keys := []string{}
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return &FieldError{Field: "data." + key, Reason: strings.Join(errs, ", ")}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package k8s_client
+
+import (
+	"bytes"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+const (
+	// DryRunServer submits the object to the API server with DryRun: All,
+	// which admits and defaults it without persisting it.
+	DryRunServer = "server"
+
+	// DryRunLocal renders the object without submitting it to the API server.
+	DryRunLocal = "local"
+
+	// OutputJSON formats rendered manifests as JSON, the default.
+	OutputJSON = "json"
+
+	// OutputYAML formats rendered manifests as YAML.
+	OutputYAML = "yaml"
+)
+
+var (
+	dryRunModes   = map[string]bool{"": true, DryRunServer: true, DryRunLocal: true}
+	outputFormats = map[string]bool{"": true, OutputJSON: true, OutputYAML: true}
+)
+
+// render formats obj as a manifest that can be applied as is, filling in its
+// kind and, for namespaced objects, namespace. The data of secrets is left
+// out, since secret values are never returned to callers.
+func render(obj runtime.Object, namespace, output string) (string, error) {
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	if err != nil {
+		return "", err
+	}
+
+	obj = obj.DeepCopyObject()
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+
+	if secret, ok := obj.(*apiv1.Secret); ok {
+		secret.Data = nil
+		secret.StringData = nil
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	if namespace != "" && accessor.GetNamespace() == "" {
+		accessor.SetNamespace(namespace)
+	}
+
+	serializer := k8sjson.NewSerializerWithOptions(k8sjson.DefaultMetaFactory, scheme.Scheme, scheme.Scheme, k8sjson.SerializerOptions{
+		Yaml:   output == OutputYAML,
+		Pretty: true,
+	})
+
+	var buf bytes.Buffer
+	if err := serializer.Encode(obj, &buf); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,156 @@
+package k8s_client
+
+import (
+	"k8s.io/api/core/v1"
+)
+
+// EnvVar is a container environment variable. Its value is either the
+// literal Value or read from a Secret or ConfigMap key, never both.
+type EnvVar struct {
+	Name            string
+	Value           string
+	SecretKeyRef    *KeySelector
+	ConfigMapKeyRef *KeySelector
+}
+
+func (e EnvVar) Validate() error {
+	if e.Name == "" {
+		return ErrMalformedEntity
+	}
+
+	refs := 0
+	for _, ref := range []*KeySelector{e.SecretKeyRef, e.ConfigMapKeyRef} {
+		if ref == nil {
+			continue
+		}
+		if err := ref.Validate(); err != nil {
+			return err
+		}
+		refs++
+	}
+
+	if refs > 1 || (refs == 1 && e.Value != "") {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// KeySelector references a single key of a Secret or ConfigMap in the
+// workload namespace.
+type KeySelector struct {
+	Name     string
+	Key      string
+	Optional bool
+}
+
+func (s KeySelector) Validate() error {
+	if s.Name == "" || s.Key == "" {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// EnvFromSource injects every key of a Secret or ConfigMap as environment
+// variables, optionally prefixed.
+type EnvFromSource struct {
+	Prefix        string
+	SecretName    string
+	ConfigMapName string
+	Optional      bool
+}
+
+func (s EnvFromSource) Validate() error {
+	if (s.SecretName == "") == (s.ConfigMapName == "") {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+func validateEnv(env []*EnvVar, envFrom []*EnvFromSource) error {
+	for _, e := range env {
+		if e == nil {
+			return ErrMalformedEntity
+		}
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range envFrom {
+		if s == nil {
+			return ErrMalformedEntity
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func envVars(env []*EnvVar) []v1.EnvVar {
+	var vars []v1.EnvVar
+	for _, e := range env {
+		if e == nil {
+			continue
+		}
+
+		envVar := v1.EnvVar{Name: e.Name, Value: e.Value}
+
+		switch {
+		case e.SecretKeyRef != nil:
+			optional := e.SecretKeyRef.Optional
+			envVar.ValueFrom = &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: e.SecretKeyRef.Name},
+					Key:                  e.SecretKeyRef.Key,
+					Optional:             &optional,
+				},
+			}
+		case e.ConfigMapKeyRef != nil:
+			optional := e.ConfigMapKeyRef.Optional
+			envVar.ValueFrom = &v1.EnvVarSource{
+				ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: e.ConfigMapKeyRef.Name},
+					Key:                  e.ConfigMapKeyRef.Key,
+					Optional:             &optional,
+				},
+			}
+		}
+
+		vars = append(vars, envVar)
+	}
+
+	return vars
+}
+
+func envFromSources(sources []*EnvFromSource) []v1.EnvFromSource {
+	var envFrom []v1.EnvFromSource
+	for _, s := range sources {
+		if s == nil {
+			continue
+		}
+
+		optional := s.Optional
+		source := v1.EnvFromSource{Prefix: s.Prefix}
+
+		if s.SecretName != "" {
+			source.SecretRef = &v1.SecretEnvSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: s.SecretName},
+				Optional:             &optional,
+			}
+		} else {
+			source.ConfigMapRef = &v1.ConfigMapEnvSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: s.ConfigMapName},
+				Optional:             &optional,
+			}
+		}
+
+		envFrom = append(envFrom, source)
+	}
+
+	return envFrom
+}
@@ -0,0 +1,358 @@
+package k8s_client
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hykuan/k8s-client-example/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ErrAlreadyExposed indicates that the Service or Ingress exposing a
+// deployment already exists.
+var ErrAlreadyExposed = errors.New(errors.AlreadyExists, "deployment is already exposed, delete its service and ingress to expose it again")
+
+var (
+	serviceTypes = map[string]bool{"": true, "ClusterIP": true, "NodePort": true, "LoadBalancer": true}
+	protocols    = map[string]bool{"": true, "TCP": true, "UDP": true, "SCTP": true}
+)
+
+// ContainerPort is a port the workload container listens on.
+type ContainerPort struct {
+	Name          string
+	ContainerPort int32
+	Protocol      string
+}
+
+func (p ContainerPort) Validate() error {
+	if !validPort(p.ContainerPort) || !protocols[p.Protocol] {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// Exposure makes a deployment reachable through a Service of the given Type,
+// ClusterIP by default, and optionally through an Ingress. Both are named
+// after the deployment and select its pods by the app label. Unlike the
+// creates, exposing takes no CreateOptions: it can't be dry run, and an
+// exposure can't be applied over an existing one.
+type Exposure struct {
+	Name      string
+	Namespace string
+	Type      string
+	Ports     []*ServicePort
+	Ingress   *IngressRule
+}
+
+func (e Exposure) Validate() error {
+	if e.Name == "" || !serviceTypes[e.Type] {
+		return ErrMalformedEntity
+	}
+
+	names := map[string]bool{}
+	for _, p := range e.Ports {
+		if p == nil {
+			return ErrMalformedEntity
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if p.NodePort != 0 && e.Type != "NodePort" && e.Type != "LoadBalancer" {
+			return ErrMalformedEntity
+		}
+		if len(e.Ports) > 1 && (p.Name == "" || names[p.Name]) {
+			return ErrMalformedEntity
+		}
+		names[p.Name] = true
+	}
+
+	if e.Ingress == nil {
+		return nil
+	}
+
+	return e.Ingress.Validate()
+}
+
+// ServicePort maps Port of the Service to TargetPort of the pods, which
+// defaults to Port.
+type ServicePort struct {
+	Name       string
+	Port       int32
+	TargetPort int32
+	NodePort   int32
+	Protocol   string
+}
+
+func (p ServicePort) Validate() error {
+	if !validPort(p.Port) || !protocols[p.Protocol] {
+		return ErrMalformedEntity
+	}
+
+	if p.TargetPort != 0 && !validPort(p.TargetPort) {
+		return ErrMalformedEntity
+	}
+
+	if p.NodePort != 0 && !validPort(p.NodePort) {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// IngressRule routes HTTP requests for Host and Path, "/" by default, to
+// ServicePort of the exposed Service, its first port by default. TLS is
+// terminated with the certificate in TLSSecretName when set.
+type IngressRule struct {
+	Host          string
+	Path          string
+	ServicePort   int32
+	TLSSecretName string
+}
+
+func (r IngressRule) Validate() error {
+	if r.Path != "" && !strings.HasPrefix(r.Path, "/") {
+		return ErrMalformedEntity
+	}
+
+	if r.ServicePort != 0 && !validPort(r.ServicePort) {
+		return ErrMalformedEntity
+	}
+
+	if r.TLSSecretName != "" && r.Host == "" {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// exposeError translates the error creating the Service or Ingress of an
+// exposure.
+func exposeError(err error) error {
+	if k8sErrors.IsAlreadyExists(err) {
+		return ErrAlreadyExposed
+	}
+
+	return translateError(err)
+}
+
+func (svc k8sClientService) ExposeDeployment(ctx context.Context, exposure Exposure) (string, error) {
+	deployment, err := svc.deploymentsClient(exposure.Namespace).Get(exposure.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", translateError(err)
+	}
+
+	ports := servicePorts(exposure.Ports)
+	if len(ports) == 0 {
+		ports = containerServicePorts(deployment.Spec.Template.Spec.Containers)
+	}
+	if len(ports) == 0 {
+		return "", ErrMalformedEntity
+	}
+
+	if exposure.Ingress != nil && exposure.Ingress.ServicePort != 0 && !hasPort(ports, exposure.Ingress.ServicePort) {
+		return "", ErrMalformedEntity
+	}
+
+	labels := map[string]string{"app": exposure.Name}
+	owners := []metav1.OwnerReference{deploymentOwner(deployment)}
+
+	services := svc.clientSet.CoreV1().Services(svc.namespace(exposure.Namespace))
+	service, err := services.Create(&apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            exposure.Name,
+			Labels:          labels,
+			OwnerReferences: owners,
+		},
+		Spec: apiv1.ServiceSpec{
+			Type:     apiv1.ServiceType(exposure.Type),
+			Selector: labels,
+			Ports:    ports,
+		},
+	})
+	if err != nil {
+		return "", exposeError(err)
+	}
+
+	if exposure.Ingress == nil {
+		return service.Name, nil
+	}
+
+	if _, err := svc.clientSet.NetworkingV1beta1().Ingresses(svc.namespace(exposure.Namespace)).Create(
+		ingress(exposure.Name, labels, owners, *exposure.Ingress, ports[0].Port),
+	); err != nil {
+		// Don't leave a half-done exposure behind; the Service is only ever
+		// created together with its Ingress.
+		services.Delete(service.Name, &metav1.DeleteOptions{})
+		return "", exposeError(err)
+	}
+
+	return service.Name, nil
+}
+
+// deploymentOwner references deployment as the owner of the objects exposing
+// it, so they are garbage collected along with it.
+func deploymentOwner(deployment *appsv1.Deployment) metav1.OwnerReference {
+	controller := true
+	return metav1.OwnerReference{
+		APIVersion: appsv1.SchemeGroupVersion.String(),
+		Kind:       "Deployment",
+		Name:       deployment.Name,
+		UID:        deployment.UID,
+		Controller: &controller,
+	}
+}
+
+func ingress(name string, labels map[string]string, owners []metav1.OwnerReference, rule IngressRule, defaultPort int32) *networkingv1beta1.Ingress {
+	path, port := rule.Path, rule.ServicePort
+	if path == "" {
+		path = "/"
+	}
+	if port == 0 {
+		port = defaultPort
+	}
+
+	ing := &networkingv1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Labels:          labels,
+			OwnerReferences: owners,
+		},
+		Spec: networkingv1beta1.IngressSpec{
+			Rules: []networkingv1beta1.IngressRule{{
+				Host: rule.Host,
+				IngressRuleValue: networkingv1beta1.IngressRuleValue{
+					HTTP: &networkingv1beta1.HTTPIngressRuleValue{
+						Paths: []networkingv1beta1.HTTPIngressPath{{
+							Path: path,
+							Backend: networkingv1beta1.IngressBackend{
+								ServiceName: name,
+								ServicePort: intstr.FromInt(int(port)),
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+
+	if rule.TLSSecretName != "" {
+		ing.Spec.TLS = []networkingv1beta1.IngressTLS{{
+			Hosts:      []string{rule.Host},
+			SecretName: rule.TLSSecretName,
+		}}
+	}
+
+	return ing
+}
+
+// validatePorts requires ports to be named uniquely when a container
+// declares more than one, so that Services can refer to them.
+func validatePorts(ports []*ContainerPort) error {
+	names := map[string]bool{}
+	for _, p := range ports {
+		if p == nil {
+			return ErrMalformedEntity
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if len(ports) > 1 && (p.Name == "" || names[p.Name]) {
+			return ErrMalformedEntity
+		}
+		names[p.Name] = true
+	}
+
+	return nil
+}
+
+func containerPorts(ports []*ContainerPort) []apiv1.ContainerPort {
+	var res []apiv1.ContainerPort
+	for _, p := range ports {
+		if p == nil {
+			continue
+		}
+
+		res = append(res, apiv1.ContainerPort{
+			Name:          p.Name,
+			ContainerPort: p.ContainerPort,
+			Protocol:      protocol(p.Protocol),
+		})
+	}
+
+	return res
+}
+
+func servicePorts(ports []*ServicePort) []apiv1.ServicePort {
+	var res []apiv1.ServicePort
+	for _, p := range ports {
+		target := p.TargetPort
+		if target == 0 {
+			target = p.Port
+		}
+
+		res = append(res, apiv1.ServicePort{
+			Name:       p.Name,
+			Port:       p.Port,
+			TargetPort: intstr.FromInt(int(target)),
+			NodePort:   p.NodePort,
+			Protocol:   protocol(p.Protocol),
+		})
+	}
+
+	return res
+}
+
+// containerServicePorts exposes every port declared by the containers on the
+// same port number.
+func containerServicePorts(containers []apiv1.Container) []apiv1.ServicePort {
+	var ports []apiv1.ContainerPort
+	for _, c := range containers {
+		ports = append(ports, c.Ports...)
+	}
+
+	var res []apiv1.ServicePort
+	for _, p := range ports {
+		name := p.Name
+		if name == "" && len(ports) > 1 {
+			name = strings.ToLower(string(p.Protocol)) + "-" + strconv.Itoa(int(p.ContainerPort))
+		}
+
+		res = append(res, apiv1.ServicePort{
+			Name:       name,
+			Port:       p.ContainerPort,
+			TargetPort: intstr.FromInt(int(p.ContainerPort)),
+			Protocol:   p.Protocol,
+		})
+	}
+
+	return res
+}
+
+func hasPort(ports []apiv1.ServicePort, port int32) bool {
+	for _, p := range ports {
+		if p.Port == port {
+			return true
+		}
+	}
+
+	return false
+}
+
+func protocol(p string) apiv1.Protocol {
+	if p == "" {
+		return apiv1.ProtocolTCP
+	}
+
+	return apiv1.Protocol(p)
+}
+
+func validPort(port int32) bool {
+	return port > 0 && port <= 65535
+}
@@ -1,8 +1,10 @@
 package k8s_client
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -10,10 +12,13 @@ const (
 )
 
 type NFSPersistentVolume struct {
-	Name    string
-	Storage string
-	Server  string
-	Path    string
+	Name             string
+	Storage          string
+	Server           string
+	Path             string
+	AccessModes      []string
+	StorageClassName string
+	Labels           map[string]string
 }
 
 func (pv NFSPersistentVolume) Validate() error {
@@ -21,12 +26,35 @@ func (pv NFSPersistentVolume) Validate() error {
 		return ErrMalformedEntity
 	}
 
-	return nil
+	return pv.PersistentVolume().Validate()
+}
+
+// PersistentVolume converts the NFS shorthand to the generic volume form.
+func (pv NFSPersistentVolume) PersistentVolume() PersistentVolume {
+	return PersistentVolume{
+		Name:             pv.Name,
+		Storage:          pv.Storage,
+		AccessModes:      pv.AccessModes,
+		StorageClassName: pv.StorageClassName,
+		Labels:           pv.Labels,
+		Source: VolumeSource{
+			NFS: &NFSVolumeSource{Server: pv.Server, Path: pv.Path},
+		},
+	}
 }
 
+// PersistentVolumeClaim requests storage. Without AccessModes it is mounted
+// ReadWriteOnce and without VolumeMode as a filesystem. VolumeName binds it to
+// a specific PV and Selector to PVs with matching labels.
 type PersistentVolumeClaim struct {
-	Name    string
-	Storage string
+	Name             string
+	Namespace        string
+	Storage          string
+	StorageClassName string
+	AccessModes      []string
+	VolumeMode       string
+	VolumeName       string
+	Selector         map[string]string
 }
 
 func (pvc PersistentVolumeClaim) Validate() error {
@@ -34,29 +62,169 @@ func (pvc PersistentVolumeClaim) Validate() error {
 		return ErrMalformedEntity
 	}
 
+	if _, err := resource.ParseQuantity(pvc.Storage); err != nil {
+		return ErrMalformedEntity
+	}
+
+	if err := validateAccessModes(pvc.AccessModes); err != nil {
+		return err
+	}
+
+	switch v1.PersistentVolumeMode(pvc.VolumeMode) {
+	case "", v1.PersistentVolumeFilesystem, v1.PersistentVolumeBlock:
+	default:
+		return ErrMalformedEntity
+	}
+
+	// A claim bound by name ignores its selector, so asking for both is
+	// almost certainly a mistake.
+	if pvc.VolumeName != "" && len(pvc.Selector) > 0 {
+		return ErrMalformedEntity
+	}
+
+	for key := range pvc.Selector {
+		if key == "" {
+			return ErrMalformedEntity
+		}
+	}
+
+	return nil
+}
+
+// accessModes maps both the full and the kubectl short access mode names.
+var accessModes = map[string]v1.PersistentVolumeAccessMode{
+	string(v1.ReadWriteOnce): v1.ReadWriteOnce,
+	string(v1.ReadOnlyMany):  v1.ReadOnlyMany,
+	string(v1.ReadWriteMany): v1.ReadWriteMany,
+	"RWO":                    v1.ReadWriteOnce,
+	"ROX":                    v1.ReadOnlyMany,
+	"RWX":                    v1.ReadWriteMany,
+}
+
+func validateAccessModes(modes []string) error {
+	seen := map[v1.PersistentVolumeAccessMode]bool{}
+	for _, mode := range modes {
+		accessMode, ok := accessModes[mode]
+		if !ok || seen[accessMode] {
+			return ErrMalformedEntity
+		}
+		seen[accessMode] = true
+	}
+
 	return nil
 }
 
+// persistentVolumeAccessModes converts validated access modes, defaulting to
+// ReadWriteOnce.
+func persistentVolumeAccessModes(modes []string) []v1.PersistentVolumeAccessMode {
+	if len(modes) == 0 {
+		return []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}
+	}
+
+	var res []v1.PersistentVolumeAccessMode
+	for _, mode := range modes {
+		res = append(res, accessModes[mode])
+	}
+
+	return res
+}
+
+func (pvc PersistentVolumeClaim) spec() (v1.PersistentVolumeClaimSpec, error) {
+	storage, err := resource.ParseQuantity(pvc.Storage)
+	if err != nil {
+		return v1.PersistentVolumeClaimSpec{}, err
+	}
+
+	volumeMode := v1.PersistentVolumeFilesystem
+	if pvc.VolumeMode != "" {
+		volumeMode = v1.PersistentVolumeMode(pvc.VolumeMode)
+	}
+
+	spec := v1.PersistentVolumeClaimSpec{
+		AccessModes: persistentVolumeAccessModes(pvc.AccessModes),
+		VolumeMode:  &volumeMode,
+		VolumeName:  pvc.VolumeName,
+		Resources: v1.ResourceRequirements{
+			Requests: v1.ResourceList{
+				"storage": storage,
+			},
+		},
+	}
+
+	if pvc.StorageClassName != "" {
+		spec.StorageClassName = &pvc.StorageClassName
+	}
+
+	if len(pvc.Selector) > 0 {
+		spec.Selector = &metav1.LabelSelector{MatchLabels: pvc.Selector}
+	}
+
+	return spec, nil
+}
+
+// Resource sets the compute resources of the workload container. CPU, Memory
+// and GPU are shorthands for the corresponding Limits, which requests default
+// to when not set.
 type Resource struct {
-	CPU    string
-	Memory string
-	GPU    string
+	CPU      string
+	Memory   string
+	GPU      string
+	Requests *ResourceQuantities
+	Limits   *ResourceQuantities
 }
 
+// VolumeInfo mounts a PVC, a Secret or a ConfigMap into the container.
+// Exactly one of PVCName, SecretName and ConfigMapName must be set.
 type VolumeInfo struct {
-	Name      string
-	PVCName   string
-	MountPath string
+	Name          string
+	PVCName       string
+	SecretName    string
+	ConfigMapName string
+	MountPath     string
+	ReadOnly      bool
+}
+
+func (v VolumeInfo) Validate() error {
+	if v.Name == "" || v.MountPath == "" {
+		return ErrMalformedEntity
+	}
+
+	sources := 0
+	for _, source := range []string{v.PVCName, v.SecretName, v.ConfigMapName} {
+		if source != "" {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		return ErrMalformedEntity
+	}
+
+	return nil
 }
 
 type Deployment struct {
 	Name      string
+	Namespace string
 	Replicas  int32
 	Image     string
 	Resource  *Resource
 	Volumes   []*VolumeInfo
 	Command   []string
 	Arguments []string
+	Env       []*EnvVar
+	EnvFrom   []*EnvFromSource
+	Ports     []*ContainerPort
+
+	// ImagePullSecrets names the registry credentials used to pull Image.
+	// When empty, the credentials matching the registry of Image are used.
+	ImagePullSecrets []string
+
+	NodeSelector      map[string]string
+	Tolerations       []*Toleration
+	Affinity          *Affinity
+	PriorityClassName string
+	SchedulerName     string
 }
 
 func (d Deployment) Validate() error {
@@ -64,7 +232,43 @@ func (d Deployment) Validate() error {
 		return ErrMalformedEntity
 	}
 
-	return nil
+	return d.validateFields()
+}
+
+// ValidatePatch validates a deployment that is to Patch an existing one, in
+// which any field but Name may be empty.
+func (d Deployment) ValidatePatch() error {
+	if d.Name == "" {
+		return ErrMalformedEntity
+	}
+
+	return d.validateFields()
+}
+
+func (d Deployment) validateFields() error {
+	if d.Resource != nil {
+		if err := d.Resource.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateVolumes(d.Volumes); err != nil {
+		return err
+	}
+
+	if err := validateEnv(d.Env, d.EnvFrom); err != nil {
+		return err
+	}
+
+	if err := validateNames(d.ImagePullSecrets); err != nil {
+		return err
+	}
+
+	if err := validatePorts(d.Ports); err != nil {
+		return err
+	}
+
+	return validateScheduling(d.NodeSelector, d.Tolerations, d.Affinity)
 }
 
 func (d *Deployment) AssignDefaultValue() {
@@ -73,53 +277,333 @@ func (d *Deployment) AssignDefaultValue() {
 	}
 }
 
-func (d Deployment) GetResourceList() v1.ResourceList {
-	list := v1.ResourceList{}
+// Patch applies the non-empty fields of d to an existing Kubernetes
+// deployment. The container created by CreateDeployment is the one updated.
+func (d Deployment) Patch(deployment *appsv1.Deployment) error {
+	containers := deployment.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		return ErrMalformedEntity
+	}
+
+	container := &containers[0]
+	for i := range containers {
+		if containers[i].Name == deployment.Name {
+			container = &containers[i]
+			break
+		}
+	}
+
+	if d.Replicas > 0 {
+		deployment.Spec.Replicas = &d.Replicas
+	}
+
+	if d.Image != "" {
+		container.Image = d.Image
+	}
+
+	if len(d.Command) > 0 {
+		container.Command = d.Command
+	}
+
+	if len(d.Arguments) > 0 {
+		container.Args = d.Arguments
+	}
+
+	if res := d.GetResources(); len(res.Limits) > 0 || len(res.Requests) > 0 {
+		container.Resources = res
+	}
+
+	if len(d.Volumes) > 0 {
+		container.VolumeMounts = d.GetVolumeMounts()
+		deployment.Spec.Template.Spec.Volumes = d.GetVolumes()
+	}
+
+	if len(d.Env) > 0 {
+		container.Env = d.GetEnv()
+	}
+
+	if len(d.EnvFrom) > 0 {
+		container.EnvFrom = d.GetEnvFrom()
+	}
 
-	if d.Resource == nil {
-		return list
+	if len(d.Ports) > 0 {
+		container.Ports = d.GetPorts()
 	}
 
-	if cpu, err := resource.ParseQuantity(d.Resource.CPU); err == nil {
-		list["cpu"] = cpu
+	spec := &deployment.Spec.Template.Spec
+
+	if len(d.NodeSelector) > 0 {
+		spec.NodeSelector = d.NodeSelector
 	}
 
-	if memory, err := resource.ParseQuantity(d.Resource.Memory); err == nil {
-		list["memory"] = memory
+	if len(d.Tolerations) > 0 {
+		spec.Tolerations = d.GetTolerations()
 	}
 
-	if gpu, err := resource.ParseQuantity(d.Resource.GPU); err == nil {
-		list["nvidia.com/gpu"] = gpu
+	if d.Affinity != nil {
+		spec.Affinity = d.GetAffinity()
 	}
 
-	return list
+	if d.PriorityClassName != "" {
+		spec.PriorityClassName = d.PriorityClassName
+	}
+
+	if d.SchedulerName != "" {
+		spec.SchedulerName = d.SchedulerName
+	}
+
+	return nil
+}
+
+func (d Deployment) GetResources() v1.ResourceRequirements {
+	return resources(d.Resource)
 }
 
 func (d Deployment) GetVolumes() []v1.Volume {
+	return volumes(d.Volumes)
+}
+
+func (d Deployment) GetVolumeMounts() []v1.VolumeMount {
+	return volumeMounts(d.Volumes)
+}
+
+func (d Deployment) GetEnv() []v1.EnvVar {
+	return envVars(d.Env)
+}
+
+func (d Deployment) GetEnvFrom() []v1.EnvFromSource {
+	return envFromSources(d.EnvFrom)
+}
+
+func (d Deployment) GetPorts() []v1.ContainerPort {
+	return containerPorts(d.Ports)
+}
+
+func (d Deployment) GetTolerations() []v1.Toleration {
+	return tolerations(d.Tolerations)
+}
+
+func (d Deployment) GetAffinity() *v1.Affinity {
+	return affinity(d.Affinity)
+}
+
+type Job struct {
+	Name                    string
+	Namespace               string
+	Image                   string
+	Resource                *Resource
+	Volumes                 []*VolumeInfo
+	Command                 []string
+	Arguments               []string
+	Env                     []*EnvVar
+	EnvFrom                 []*EnvFromSource
+	BackoffLimit            *int32
+	ActiveDeadlineSeconds   *int64
+	Completions             *int32
+	Parallelism             *int32
+	TTLSecondsAfterFinished *int32
+
+	// ImagePullSecrets names the registry credentials used to pull Image.
+	// When empty, the credentials matching the registry of Image are used.
+	ImagePullSecrets []string
+
+	NodeSelector      map[string]string
+	Tolerations       []*Toleration
+	Affinity          *Affinity
+	PriorityClassName string
+	SchedulerName     string
+}
+
+func (j Job) Validate() error {
+	if j.Name == "" || j.Image == "" {
+		return ErrMalformedEntity
+	}
+
+	for _, v := range []*int32{j.BackoffLimit, j.Completions, j.Parallelism, j.TTLSecondsAfterFinished} {
+		if v != nil && *v < 0 {
+			return ErrMalformedEntity
+		}
+	}
+
+	if j.ActiveDeadlineSeconds != nil && *j.ActiveDeadlineSeconds <= 0 {
+		return ErrMalformedEntity
+	}
+
+	if j.Resource != nil {
+		if err := j.Resource.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateVolumes(j.Volumes); err != nil {
+		return err
+	}
+
+	if err := validateEnv(j.Env, j.EnvFrom); err != nil {
+		return err
+	}
+
+	if err := validateNames(j.ImagePullSecrets); err != nil {
+		return err
+	}
+
+	return validateScheduling(j.NodeSelector, j.Tolerations, j.Affinity)
+}
+
+func (j Job) GetResources() v1.ResourceRequirements {
+	return resources(j.Resource)
+}
+
+func (j Job) GetVolumes() []v1.Volume {
+	return volumes(j.Volumes)
+}
+
+func (j Job) GetVolumeMounts() []v1.VolumeMount {
+	return volumeMounts(j.Volumes)
+}
+
+func (j Job) GetEnv() []v1.EnvVar {
+	return envVars(j.Env)
+}
+
+func (j Job) GetEnvFrom() []v1.EnvFromSource {
+	return envFromSources(j.EnvFrom)
+}
+
+func (j Job) GetTolerations() []v1.Toleration {
+	return tolerations(j.Tolerations)
+}
+
+func (j Job) GetAffinity() *v1.Affinity {
+	return affinity(j.Affinity)
+}
+
+func validateVolumes(infos []*VolumeInfo) error {
+	for _, v := range infos {
+		if v == nil {
+			return ErrMalformedEntity
+		}
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateNames(names []string) error {
+	for _, name := range names {
+		if name == "" {
+			return ErrMalformedEntity
+		}
+	}
+
+	return nil
+}
+
+func volumes(infos []*VolumeInfo) []v1.Volume {
 	var volumes []v1.Volume
-	for _, v := range d.Volumes {
-		volumes = append(volumes, v1.Volume{
-			Name: v.Name,
-			VolumeSource: v1.VolumeSource{
-				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
-					ClaimName: v.Name,
-				},
-			},
-		})
+	for _, v := range infos {
+		if v == nil {
+			continue
+		}
+
+		volume := v1.Volume{Name: v.Name}
+
+		switch {
+		case v.SecretName != "":
+			volume.Secret = &v1.SecretVolumeSource{SecretName: v.SecretName}
+		case v.ConfigMapName != "":
+			volume.ConfigMap = &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: v.ConfigMapName},
+			}
+		default:
+			volume.PersistentVolumeClaim = &v1.PersistentVolumeClaimVolumeSource{
+				ClaimName: v.PVCName,
+				ReadOnly:  v.ReadOnly,
+			}
+		}
+
+		volumes = append(volumes, volume)
 	}
 
 	return volumes
 }
 
-func (d Deployment) GetVolumeMounts() []v1.VolumeMount {
+func volumeMounts(infos []*VolumeInfo) []v1.VolumeMount {
 	var volumeMounts []v1.VolumeMount
-	for _, v := range d.Volumes {
+	for _, v := range infos {
+		if v == nil {
+			continue
+		}
+
 		volumeMounts = append(volumeMounts, v1.VolumeMount{
-			Name: v.Name,
+			Name:      v.Name,
 			MountPath: v.MountPath,
+			ReadOnly:  v.ReadOnly,
 		})
 	}
 
 	return volumeMounts
 }
 
+type PersistentVolumeStatus struct {
+	Name           string
+	Storage        string
+	Phase          string
+	Reason         string
+	ClaimNamespace string
+	ClaimName      string
+}
+
+type PersistentVolumeClaimStatus struct {
+	Name       string
+	Namespace  string
+	Storage    string
+	Phase      string
+	VolumeName string
+	Capacity   string
+}
+
+type DeploymentStatus struct {
+	Name                string
+	Namespace           string
+	Image               string
+	Replicas            int32
+	UpdatedReplicas     int32
+	ReadyReplicas       int32
+	AvailableReplicas   int32
+	UnavailableReplicas int32
+}
+
+type DeleteOptions struct {
+	PropagationPolicy  string
+	GracePeriodSeconds *int64
+}
+
+func (o DeleteOptions) Validate() error {
+	switch metav1.DeletionPropagation(o.PropagationPolicy) {
+	case "", metav1.DeletePropagationForeground, metav1.DeletePropagationBackground, metav1.DeletePropagationOrphan:
+	default:
+		return ErrMalformedEntity
+	}
+
+	if o.GracePeriodSeconds != nil && *o.GracePeriodSeconds < 0 {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+func (o DeleteOptions) toDeleteOptions() *metav1.DeleteOptions {
+	opts := &metav1.DeleteOptions{
+		GracePeriodSeconds: o.GracePeriodSeconds,
+	}
+
+	if o.PropagationPolicy != "" {
+		policy := metav1.DeletionPropagation(o.PropagationPolicy)
+		opts.PropagationPolicy = &policy
+	}
+
+	return opts
+}
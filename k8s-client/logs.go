@@ -0,0 +1,173 @@
+package k8s_client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/hykuan/k8s-client-example/errors"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// LogOptions selects which part of the workload logs is streamed.
+type LogOptions struct {
+	Container string
+	Follow    bool
+	TailLines *int64
+}
+
+func (o LogOptions) Validate() error {
+	if o.TailLines != nil && *o.TailLines < 0 {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// LogLine is a single timestamped line written by a container of a workload
+// pod. A line with Err set is the last one of its pod and reports why the pod
+// log couldn't be read to the end.
+type LogLine struct {
+	Pod       string
+	Container string
+	Timestamp string
+	Message   string
+	Err       error
+}
+
+func (svc k8sClientService) StreamLogs(ctx context.Context, namespace, name string, opts LogOptions) (<-chan LogLine, error) {
+	podsClient := svc.clientSet.CoreV1().Pods(svc.namespace(namespace))
+
+	pods, err := podsClient.List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{"app": name}).String(),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, ErrNotFound
+	}
+
+	var streams []io.ReadCloser
+	closeAll := func() {
+		for _, s := range streams {
+			s.Close()
+		}
+	}
+
+	var containers []string
+	for _, pod := range pods.Items {
+		container := logContainer(pod, name, opts.Container)
+		stream, err := podsClient.GetLogs(pod.Name, &apiv1.PodLogOptions{
+			Container:  container,
+			Follow:     opts.Follow,
+			TailLines:  opts.TailLines,
+			Timestamps: true,
+		}).Context(ctx).Stream()
+		if err != nil {
+			closeAll()
+			return nil, translateError(err)
+		}
+
+		streams = append(streams, stream)
+		containers = append(containers, container)
+	}
+
+	lines := make(chan LogLine)
+	finished := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i, stream := range streams {
+		wg.Add(1)
+		go func(pod, container string, stream io.Reader) {
+			defer wg.Done()
+
+			send := func(line LogLine) bool {
+				select {
+				case lines <- line:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			}
+
+			// Unlike bufio.Scanner, bufio.Reader doesn't cap the line length,
+			// so long lines are forwarded instead of ending the stream.
+			reader := bufio.NewReader(stream)
+			for {
+				text, err := reader.ReadString('\n')
+				if text != "" && !send(toLogLine(pod, container, strings.TrimRight(text, "\r\n"))) {
+					return
+				}
+
+				switch {
+				case err == io.EOF, err != nil && ctx.Err() != nil:
+					return
+				case err != nil:
+					send(LogLine{
+						Pod:       pod,
+						Container: container,
+						Err:       errors.New(errors.Unavailable, fmt.Sprintf("failed to read logs of pod %s: %s", pod, err)),
+					})
+					return
+				}
+			}
+		}(pods.Items[i].Name, containers[i], stream)
+	}
+
+	// Closing the streams unblocks readers still waiting on a followed log
+	// once the caller is gone.
+	go func() {
+		select {
+		case <-ctx.Done():
+			closeAll()
+		case <-finished:
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		closeAll()
+		close(finished)
+		close(lines)
+	}()
+
+	return lines, nil
+}
+
+// logContainer picks the requested container, falling back to the one
+// created by CreateDeployment and CreateJob, which is named after the workload.
+func logContainer(pod apiv1.Pod, workload, requested string) string {
+	if requested != "" {
+		return requested
+	}
+
+	for _, c := range pod.Spec.Containers {
+		if c.Name == workload {
+			return c.Name
+		}
+	}
+
+	return ""
+}
+
+func toLogLine(pod, container, text string) LogLine {
+	line := LogLine{
+		Pod:       pod,
+		Container: container,
+		Message:   text,
+	}
+
+	if i := strings.IndexByte(text, ' '); i > 0 {
+		line.Timestamp = text[:i]
+		line.Message = text[i+1:]
+	}
+
+	return line
+}
@@ -0,0 +1,106 @@
+package k8s_client
+
+import (
+	"context"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// NodeMetrics is the current CPU and memory usage of a cluster node, as
+// reported by metrics-server.
+type NodeMetrics struct {
+	Name      string
+	CPU       string
+	Memory    string
+	Timestamp string
+}
+
+// ContainerMetrics is the current CPU and memory usage of a pod container.
+type ContainerMetrics struct {
+	Name   string
+	CPU    string
+	Memory string
+}
+
+// PodMetrics is the current CPU and memory usage of a workload pod, summed
+// over its containers.
+type PodMetrics struct {
+	Name       string
+	Namespace  string
+	CPU        string
+	Memory     string
+	Timestamp  string
+	Containers []ContainerMetrics
+}
+
+func (svc k8sClientService) GetNodeMetrics(ctx context.Context) ([]NodeMetrics, error) {
+	list, err := svc.metricsClient.MetricsV1beta1().NodeMetricses().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	nodes := []NodeMetrics{}
+	for _, m := range list.Items {
+		nodes = append(nodes, NodeMetrics{
+			Name:      m.Name,
+			CPU:       quantity(m.Usage, apiv1.ResourceCPU),
+			Memory:    quantity(m.Usage, apiv1.ResourceMemory),
+			Timestamp: m.Timestamp.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return nodes, nil
+}
+
+func (svc k8sClientService) GetPodMetrics(ctx context.Context, namespace, name string) ([]PodMetrics, error) {
+	list, err := svc.metricsClient.MetricsV1beta1().PodMetricses(svc.namespace(namespace)).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{"app": name}).String(),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	pods := []PodMetrics{}
+	for _, m := range list.Items {
+		pods = append(pods, toPodMetrics(m))
+	}
+
+	return pods, nil
+}
+
+func toPodMetrics(m metricsv1beta1.PodMetrics) PodMetrics {
+	total := apiv1.ResourceList{}
+	pod := PodMetrics{
+		Name:      m.Name,
+		Namespace: m.Namespace,
+		Timestamp: m.Timestamp.UTC().Format(time.RFC3339),
+	}
+
+	for _, c := range m.Containers {
+		pod.Containers = append(pod.Containers, ContainerMetrics{
+			Name:   c.Name,
+			CPU:    quantity(c.Usage, apiv1.ResourceCPU),
+			Memory: quantity(c.Usage, apiv1.ResourceMemory),
+		})
+
+		addResources(total, c.Usage)
+	}
+
+	pod.CPU = quantity(total, apiv1.ResourceCPU)
+	pod.Memory = quantity(total, apiv1.ResourceMemory)
+
+	return pod
+}
+
+func quantity(list apiv1.ResourceList, name apiv1.ResourceName) string {
+	q, ok := list[name]
+	if !ok {
+		return ""
+	}
+
+	return q.String()
+}
@@ -0,0 +1,97 @@
+// Package mocks contains an in-memory k8s-client service backed by the
+// client-go fake clientsets, for use in tests of this and dependent packages.
+package mocks
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/hykuan/k8s-client-example/k8s-client"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	restfake "k8s.io/client-go/rest/fake"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+)
+
+// Harness bundles a k8s-client service with the fake clientsets it talks to,
+// so tests can seed cluster state and inspect the objects the service renders.
+// Logs holds the log served for each pod, by pod name, which the fake
+// clientset can't serve itself.
+type Harness struct {
+	Service   k8s_client.Service
+	ClientSet *fake.Clientset
+	Metrics   *metricsfake.Clientset
+	Logs      map[string]io.Reader
+}
+
+// NewHarness returns a service using namespace as its default namespace and
+// a fake cluster pre-populated with objects.
+func NewHarness(namespace string, objects ...runtime.Object) Harness {
+	clientSet := fake.NewSimpleClientset(objects...)
+	metricsClient := metricsfake.NewSimpleClientset()
+	logs := map[string]io.Reader{}
+
+	return Harness{
+		Service:   k8s_client.New(logsClientSet{clientSet, logs}, metricsClient, namespace),
+		ClientSet: clientSet,
+		Metrics:   metricsClient,
+		Logs:      logs,
+	}
+}
+
+type logsClientSet struct {
+	*fake.Clientset
+	logs map[string]io.Reader
+}
+
+func (c logsClientSet) CoreV1() corev1.CoreV1Interface {
+	return logsCoreV1{c.Clientset.CoreV1(), c.logs}
+}
+
+type logsCoreV1 struct {
+	corev1.CoreV1Interface
+	logs map[string]io.Reader
+}
+
+func (c logsCoreV1) Pods(namespace string) corev1.PodInterface {
+	return logsPods{c.CoreV1Interface.Pods(namespace), c.logs}
+}
+
+type logsPods struct {
+	corev1.PodInterface
+	logs map[string]io.Reader
+}
+
+// GetLogs records the action like the fake clientset does, and serves the
+// log registered for the pod, or an empty one. Logs that are also closers are
+// closed along with the stream, like a followed log is.
+func (p logsPods) GetLogs(name string, opts *apiv1.PodLogOptions) *rest.Request {
+	p.PodInterface.GetLogs(name, opts)
+
+	log, ok := p.logs[name]
+	if !ok {
+		log = strings.NewReader("")
+	}
+
+	body, ok := log.(io.ReadCloser)
+	if !ok {
+		body = ioutil.NopCloser(log)
+	}
+
+	client := &restfake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		Resp: &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			Body:       body,
+		},
+	}
+
+	return client.Get().Resource("pods").Name(name).SubResource("log")
+}
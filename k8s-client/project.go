@@ -0,0 +1,438 @@
+package k8s_client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// projectLabel marks the namespaces provisioned for projects, so that
+	// namespaces created by other means are never updated or deleted.
+	projectLabel = "quaistudio.io/project"
+
+	// projectRole is the cluster role the project service account is granted
+	// within its namespace.
+	projectRole = "edit"
+
+	gpuQuotaResource apiv1.ResourceName = apiv1.DefaultResourceRequestsPrefix + gpuResource
+)
+
+// defaultRequests are the container requests of a project when its limits
+// set neither a default request nor a default limit for the resource.
+var defaultRequests = apiv1.ResourceList{
+	apiv1.ResourceCPU:    resource.MustParse("100m"),
+	apiv1.ResourceMemory: resource.MustParse("128Mi"),
+}
+
+// Project is a tenant of the cluster. It owns a namespace of the same name,
+// bounded by Quota, in which containers that don't state their resources get
+// those of Limits, and a service account of the same name that may manage the
+// workloads of the namespace.
+type Project struct {
+	Name   string
+	Quota  ProjectQuota
+	Limits ProjectLimits
+}
+
+func (p Project) Validate() error {
+	if p.Name == "" {
+		return ErrMalformedEntity
+	}
+
+	if errs := validation.IsDNS1123Label(p.Name); len(errs) > 0 {
+		return &FieldError{Field: "name", Reason: strings.Join(errs, ", ")}
+	}
+
+	if _, err := p.Quota.hard(); err != nil {
+		return err
+	}
+
+	_, err := p.Limits.limitRange()
+	return err
+}
+
+// ProjectQuota bounds the total requests of a project in Kubernetes quantity
+// notation. PVCs is the number of persistent volume claims. Empty values are
+// unbounded.
+type ProjectQuota struct {
+	CPU     string
+	Memory  string
+	GPU     string
+	PVCs    string
+	Storage string
+}
+
+// ProjectLimits holds the requests and limits given to containers of a
+// project that don't state their own. CPU and memory requests default to
+// 100m and 128Mi unless a default request or limit is set for them.
+type ProjectLimits struct {
+	Default        ResourceQuantities
+	DefaultRequest ResourceQuantities
+}
+
+// ProjectStatus describes a project along with the resources it uses.
+type ProjectStatus struct {
+	Name           string
+	Phase          string
+	Quota          ProjectQuota
+	Used           ProjectQuota
+	Limits         ProjectLimits
+	ServiceAccount string
+}
+
+var quotaFields = []struct {
+	name  apiv1.ResourceName
+	field string
+	value func(*ProjectQuota) *string
+}{
+	{apiv1.ResourceRequestsCPU, "quota.cpu", func(q *ProjectQuota) *string { return &q.CPU }},
+	{apiv1.ResourceRequestsMemory, "quota.memory", func(q *ProjectQuota) *string { return &q.Memory }},
+	{gpuQuotaResource, "quota.gpu", func(q *ProjectQuota) *string { return &q.GPU }},
+	{apiv1.ResourcePersistentVolumeClaims, "quota.pvcs", func(q *ProjectQuota) *string { return &q.PVCs }},
+	{apiv1.ResourceRequestsStorage, "quota.storage", func(q *ProjectQuota) *string { return &q.Storage }},
+}
+
+func (q ProjectQuota) hard() (apiv1.ResourceList, error) {
+	list := apiv1.ResourceList{}
+	for _, f := range quotaFields {
+		if err := parseQuantity(list, f.name, f.field, *f.value(&q)); err != nil {
+			return nil, err
+		}
+	}
+
+	if pvcs, ok := list[apiv1.ResourcePersistentVolumeClaims]; ok && pvcs.MilliValue()%1000 != 0 {
+		return nil, &FieldError{Field: "quota.pvcs", Reason: fmt.Sprintf("%q is not a whole number", q.PVCs)}
+	}
+
+	return list, nil
+}
+
+func toProjectQuota(list apiv1.ResourceList) ProjectQuota {
+	var q ProjectQuota
+	for _, f := range quotaFields {
+		if value, ok := list[f.name]; ok {
+			*f.value(&q) = value.String()
+		}
+	}
+
+	return q
+}
+
+func (l ProjectLimits) limitRange() (apiv1.LimitRangeItem, error) {
+	limits, err := l.Default.list("limits.default")
+	if err != nil {
+		return apiv1.LimitRangeItem{}, err
+	}
+
+	requests, err := l.DefaultRequest.list("limits.defaultRequest")
+	if err != nil {
+		return apiv1.LimitRangeItem{}, err
+	}
+
+	for name, q := range defaultRequests {
+		_, limited := limits[name]
+		if _, ok := requests[name]; !ok && !limited {
+			requests[name] = q
+		}
+	}
+
+	for _, name := range sortedNames(requests) {
+		q := requests[name]
+		if limit, ok := limits[name]; ok && q.Cmp(limit) > 0 {
+			return apiv1.LimitRangeItem{}, &FieldError{
+				Field:  "limits.defaultRequest." + resourceField(name),
+				Reason: fmt.Sprintf("%s exceeds the default limit of %s", q.String(), limit.String()),
+			}
+		}
+	}
+
+	item := apiv1.LimitRangeItem{
+		Type:           apiv1.LimitTypeContainer,
+		DefaultRequest: requests,
+	}
+	if len(limits) > 0 {
+		item.Default = limits
+	}
+
+	return item, nil
+}
+
+func toProjectLimits(item apiv1.LimitRangeItem) ProjectLimits {
+	return ProjectLimits{
+		Default:        toResourceQuantities(item.Default),
+		DefaultRequest: toResourceQuantities(item.DefaultRequest),
+	}
+}
+
+func toResourceQuantities(list apiv1.ResourceList) ResourceQuantities {
+	var q ResourceQuantities
+	for name, value := range list {
+		switch {
+		case name == apiv1.ResourceCPU:
+			q.CPU = value.String()
+		case name == apiv1.ResourceMemory:
+			q.Memory = value.String()
+		case name == gpuResource:
+			q.GPU = value.String()
+		case name == apiv1.ResourceEphemeralStorage:
+			q.EphemeralStorage = value.String()
+		case strings.HasPrefix(string(name), apiv1.ResourceHugePagesPrefix):
+			if q.HugePages == nil {
+				q.HugePages = map[string]string{}
+			}
+			q.HugePages[strings.TrimPrefix(string(name), apiv1.ResourceHugePagesPrefix)] = value.String()
+		}
+	}
+
+	return q
+}
+
+// CreateProject provisions the namespace of a project and the objects that
+// bound it. Should provisioning fail once the namespace exists, UpdateProject
+// completes it.
+func (svc k8sClientService) CreateProject(ctx context.Context, p Project) (string, error) {
+	ns := &apiv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   p.Name,
+			Labels: map[string]string{projectLabel: p.Name},
+		},
+	}
+
+	if _, err := svc.clientSet.CoreV1().Namespaces().Create(ns); err != nil {
+		return "", translateError(err)
+	}
+
+	if err := svc.reconcileProject(p); err != nil {
+		return "", err
+	}
+
+	return p.Name, nil
+}
+
+func (svc k8sClientService) GetProject(ctx context.Context, name string) (ProjectStatus, error) {
+	ns, err := svc.projectNamespace(name)
+	if err != nil {
+		return ProjectStatus{}, err
+	}
+
+	status := ProjectStatus{
+		Name:  ns.Name,
+		Phase: string(ns.Status.Phase),
+	}
+
+	quota, err := svc.clientSet.CoreV1().ResourceQuotas(name).Get(name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		status.Quota = toProjectQuota(quota.Spec.Hard)
+		status.Used = toProjectQuota(quota.Status.Used)
+	case !k8sErrors.IsNotFound(err):
+		return ProjectStatus{}, translateError(err)
+	}
+
+	limitRange, err := svc.clientSet.CoreV1().LimitRanges(name).Get(name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type == apiv1.LimitTypeContainer {
+				status.Limits = toProjectLimits(item)
+			}
+		}
+	case !k8sErrors.IsNotFound(err):
+		return ProjectStatus{}, translateError(err)
+	}
+
+	sa, err := svc.clientSet.CoreV1().ServiceAccounts(name).Get(name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		status.ServiceAccount = sa.Name
+	case !k8sErrors.IsNotFound(err):
+		return ProjectStatus{}, translateError(err)
+	}
+
+	return status, nil
+}
+
+// UpdateProject reconciles the objects of an existing project with p,
+// recreating any that were removed.
+func (svc k8sClientService) UpdateProject(ctx context.Context, p Project) (string, error) {
+	if _, err := svc.projectNamespace(p.Name); err != nil {
+		return "", err
+	}
+
+	if err := svc.reconcileProject(p); err != nil {
+		return "", err
+	}
+
+	return p.Name, nil
+}
+
+// DeleteProject deletes the namespace of a project, which tears down
+// everything it holds. Namespaces that aren't projects are reported as not
+// found and left untouched.
+func (svc k8sClientService) DeleteProject(ctx context.Context, name string, opts DeleteOptions) error {
+	if _, err := svc.projectNamespace(name); err != nil {
+		return err
+	}
+
+	return translateError(svc.clientSet.CoreV1().Namespaces().Delete(name, opts.toDeleteOptions()))
+}
+
+func (svc k8sClientService) projectNamespace(name string) (*apiv1.Namespace, error) {
+	ns, err := svc.clientSet.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	if ns.Labels[projectLabel] != name {
+		return nil, ErrNotFound
+	}
+
+	return ns, nil
+}
+
+func (svc k8sClientService) reconcileProject(p Project) error {
+	hard, err := p.Quota.hard()
+	if err != nil {
+		return err
+	}
+
+	limits, err := p.Limits.limitRange()
+	if err != nil {
+		return err
+	}
+
+	meta := metav1.ObjectMeta{
+		Name:      p.Name,
+		Namespace: p.Name,
+		Labels:    map[string]string{projectLabel: p.Name},
+	}
+
+	for _, reconcile := range []func() error{
+		svc.reconcileQuota(&apiv1.ResourceQuota{ObjectMeta: meta, Spec: apiv1.ResourceQuotaSpec{Hard: hard}}),
+		svc.reconcileLimitRange(&apiv1.LimitRange{ObjectMeta: meta, Spec: apiv1.LimitRangeSpec{Limits: []apiv1.LimitRangeItem{limits}}}),
+		svc.reconcileServiceAccount(&apiv1.ServiceAccount{ObjectMeta: meta}),
+		svc.reconcileRoleBinding(&rbacv1.RoleBinding{
+			ObjectMeta: meta,
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      p.Name,
+				Namespace: p.Name,
+			}},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     projectRole,
+			},
+		}),
+	} {
+		if err := retry.RetryOnConflict(retry.DefaultRetry, reconcile); err != nil {
+			return translateError(err)
+		}
+	}
+
+	return nil
+}
+
+func (svc k8sClientService) reconcileQuota(desired *apiv1.ResourceQuota) func() error {
+	client := svc.clientSet.CoreV1().ResourceQuotas(desired.Namespace)
+
+	return func() error {
+		quota, err := client.Get(desired.Name, metav1.GetOptions{})
+		if k8sErrors.IsNotFound(err) {
+			_, err = client.Create(desired)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(quota.Spec.Hard, desired.Spec.Hard) {
+			return nil
+		}
+
+		merged := quota.DeepCopy()
+		merged.Spec.Hard = desired.Spec.Hard
+		_, err = client.Update(merged)
+		return err
+	}
+}
+
+func (svc k8sClientService) reconcileLimitRange(desired *apiv1.LimitRange) func() error {
+	client := svc.clientSet.CoreV1().LimitRanges(desired.Namespace)
+
+	return func() error {
+		limitRange, err := client.Get(desired.Name, metav1.GetOptions{})
+		if k8sErrors.IsNotFound(err) {
+			_, err = client.Create(desired)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(limitRange.Spec, desired.Spec) {
+			return nil
+		}
+
+		merged := limitRange.DeepCopy()
+		merged.Spec = desired.Spec
+		_, err = client.Update(merged)
+		return err
+	}
+}
+
+func (svc k8sClientService) reconcileServiceAccount(desired *apiv1.ServiceAccount) func() error {
+	client := svc.clientSet.CoreV1().ServiceAccounts(desired.Namespace)
+
+	return func() error {
+		_, err := client.Create(desired)
+		if k8sErrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+}
+
+// reconcileRoleBinding recreates the binding when its role differs, as the
+// role of a binding can't be changed.
+func (svc k8sClientService) reconcileRoleBinding(desired *rbacv1.RoleBinding) func() error {
+	client := svc.clientSet.RbacV1().RoleBindings(desired.Namespace)
+
+	return func() error {
+		binding, err := client.Get(desired.Name, metav1.GetOptions{})
+		if k8sErrors.IsNotFound(err) {
+			_, err = client.Create(desired)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if binding.RoleRef != desired.RoleRef {
+			if err := client.Delete(desired.Name, &metav1.DeleteOptions{}); err != nil {
+				return err
+			}
+			_, err = client.Create(desired)
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(binding.Subjects, desired.Subjects) {
+			return nil
+		}
+
+		merged := binding.DeepCopy()
+		merged.Subjects = desired.Subjects
+		_, err = client.Update(merged)
+		return err
+	}
+}
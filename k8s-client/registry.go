@@ -0,0 +1,236 @@
+package k8s_client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// dockerHub is the registry host of images without an explicit registry,
+// such as "ubuntu" or "tensorflow/tensorflow".
+const dockerHub = "docker.io"
+
+// RegistryCredential authenticates image pulls from a private registry. It is
+// stored as a kubernetes.io/dockerconfigjson Secret named Name.
+type RegistryCredential struct {
+	Name      string
+	Namespace string
+	Server    string
+	Username  string
+	Token     string
+}
+
+func (c RegistryCredential) Validate() error {
+	if c.Name == "" || c.Username == "" || c.Token == "" {
+		return ErrMalformedEntity
+	}
+
+	if registryServer(c.Server) == "" {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// RegistryCredentialStatus describes a stored registry credential. The token
+// is never read back.
+type RegistryCredentialStatus struct {
+	Name      string
+	Namespace string
+	Servers   []string
+	Username  string
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+func (svc k8sClientService) secretsClient(namespace string) corev1.SecretInterface {
+	return svc.clientSet.CoreV1().Secrets(svc.namespace(namespace))
+}
+
+func (svc k8sClientService) CreateRegistryCredential(ctx context.Context, cred RegistryCredential, opts CreateOptions) (CreateResult, error) {
+	config, err := json.Marshal(dockerConfig{
+		Auths: map[string]dockerAuth{
+			registryServer(cred.Server): {
+				Username: cred.Username,
+				Password: cred.Token,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(cred.Username + ":" + cred.Token)),
+			},
+		},
+	})
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cred.Name,
+		},
+		Type: apiv1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			apiv1.DockerConfigJsonKey: config,
+		},
+	}
+
+	create := func() error {
+		_, err := svc.secretsClient(cred.Namespace).Create(secret)
+		return err
+	}
+
+	return svc.create(ctx, opts, svc.clientSet.CoreV1().RESTClient(), "secrets", svc.namespace(cred.Namespace), secret, create, svc.applySecret(cred.Namespace, secret))
+}
+
+func (svc k8sClientService) ListRegistryCredentials(ctx context.Context, namespace string) ([]RegistryCredentialStatus, error) {
+	secrets, err := svc.registrySecrets(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := []RegistryCredentialStatus{}
+	for _, secret := range secrets {
+		creds = append(creds, toRegistryCredentialStatus(secret))
+	}
+
+	return creds, nil
+}
+
+// DeleteRegistryCredential deletes the named registry credential. Secrets of
+// any other type are reported as not found and left untouched.
+func (svc k8sClientService) DeleteRegistryCredential(ctx context.Context, namespace, name string, opts DeleteOptions) error {
+	client := svc.secretsClient(namespace)
+
+	secret, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return translateError(err)
+	}
+
+	if secret.Type != apiv1.SecretTypeDockerConfigJson {
+		return ErrNotFound
+	}
+
+	return translateError(client.Delete(name, opts.toDeleteOptions()))
+}
+
+// imagePullSecrets references the named secrets or, when there are none, the
+// registry credentials in the namespace that hold auths for the registry of
+// image.
+func (svc k8sClientService) imagePullSecrets(namespace, image string, names []string) ([]apiv1.LocalObjectReference, error) {
+	var refs []apiv1.LocalObjectReference
+	for _, name := range names {
+		refs = append(refs, apiv1.LocalObjectReference{Name: name})
+	}
+
+	if len(refs) > 0 || image == "" {
+		return refs, nil
+	}
+
+	secrets, err := svc.registrySecrets(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	host := imageRegistry(image)
+	for _, secret := range secrets {
+		for _, server := range toRegistryCredentialStatus(secret).Servers {
+			if server == host {
+				refs = append(refs, apiv1.LocalObjectReference{Name: secret.Name})
+				break
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+func (svc k8sClientService) registrySecrets(namespace string) ([]apiv1.Secret, error) {
+	list, err := svc.secretsClient(namespace).List(metav1.ListOptions{
+		FieldSelector: "type=" + string(apiv1.SecretTypeDockerConfigJson),
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var secrets []apiv1.Secret
+	for _, secret := range list.Items {
+		if secret.Type == apiv1.SecretTypeDockerConfigJson {
+			secrets = append(secrets, secret)
+		}
+	}
+
+	return secrets, nil
+}
+
+func toRegistryCredentialStatus(secret apiv1.Secret) RegistryCredentialStatus {
+	status := RegistryCredentialStatus{
+		Name:      secret.Name,
+		Namespace: secret.Namespace,
+		Servers:   []string{},
+	}
+
+	var config dockerConfig
+	if err := json.Unmarshal(secret.Data[apiv1.DockerConfigJsonKey], &config); err != nil {
+		return status
+	}
+
+	var servers []string
+	for server := range config.Auths {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+
+	for _, server := range servers {
+		status.Servers = append(status.Servers, registryServer(server))
+		if status.Username == "" {
+			status.Username = config.Auths[server].Username
+		}
+	}
+
+	return status
+}
+
+// registryServer normalizes a registry address as found in docker configs,
+// e.g. "https://index.docker.io/v1/", to the host used in image names.
+func registryServer(server string) string {
+	server = strings.TrimPrefix(server, "https://")
+	server = strings.TrimPrefix(server, "http://")
+	if i := strings.IndexByte(server, '/'); i >= 0 {
+		server = server[:i]
+	}
+
+	switch server {
+	case "index.docker.io", "registry-1.docker.io":
+		return dockerHub
+	}
+
+	return server
+}
+
+// imageRegistry returns the registry host of an image reference, following
+// the Docker convention that the first path component is a registry only if
+// it looks like a host name.
+func imageRegistry(image string) string {
+	i := strings.IndexByte(image, '/')
+	if i < 0 {
+		return dockerHub
+	}
+
+	host := image[:i]
+	if !strings.ContainsAny(host, ".:") && host != "localhost" {
+		return dockerHub
+	}
+
+	return registryServer(host)
+}
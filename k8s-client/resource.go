@@ -0,0 +1,192 @@
+package k8s_client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// resourceFields maps the resources that have a ResourceQuantities field to
+// the name of that field.
+var resourceFields = map[v1.ResourceName]string{
+	v1.ResourceCPU:              "cpu",
+	v1.ResourceMemory:           "memory",
+	gpuResource:                 "gpu",
+	v1.ResourceEphemeralStorage: "ephemeralStorage",
+}
+
+// ResourceQuantities lists compute resources in Kubernetes quantity notation
+// (e.g. "500m", "4Gi"). HugePages is keyed by page size, e.g. "2Mi" or "1Gi".
+type ResourceQuantities struct {
+	CPU              string
+	Memory           string
+	GPU              string
+	EphemeralStorage string
+	HugePages        map[string]string
+}
+
+// list parses the quantities, naming the offending field relative to prefix
+// when one of them is invalid.
+func (q ResourceQuantities) list(prefix string) (v1.ResourceList, error) {
+	list := v1.ResourceList{}
+
+	for _, r := range []struct {
+		name  v1.ResourceName
+		value string
+	}{
+		{v1.ResourceCPU, q.CPU},
+		{v1.ResourceMemory, q.Memory},
+		{gpuResource, q.GPU},
+		{v1.ResourceEphemeralStorage, q.EphemeralStorage},
+	} {
+		if err := parseQuantity(list, r.name, prefix+"."+resourceFields[r.name], r.value); err != nil {
+			return nil, err
+		}
+	}
+
+	var sizes []string
+	for size := range q.HugePages {
+		sizes = append(sizes, size)
+	}
+	sort.Strings(sizes)
+
+	for _, size := range sizes {
+		value := q.HugePages[size]
+		field := prefix + ".hugePages." + size
+		pageSize, err := resource.ParseQuantity(size)
+		if err != nil || pageSize.Sign() <= 0 {
+			return nil, &FieldError{Field: field, Reason: fmt.Sprintf("%q is not a valid page size", size)}
+		}
+
+		name := v1.ResourceName(v1.ResourceHugePagesPrefix + pageSize.String())
+		if value == "" {
+			return nil, &FieldError{Field: field, Reason: "quantity is required"}
+		}
+		if err := parseQuantity(list, name, field, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+// requirements renders the resource into container requests and limits.
+func (r Resource) requirements() (v1.ResourceRequirements, error) {
+	limits, err := ResourceQuantities{CPU: r.CPU, Memory: r.Memory, GPU: r.GPU}.list("resource")
+	if err != nil {
+		return v1.ResourceRequirements{}, err
+	}
+
+	if r.Limits != nil {
+		explicit, err := r.Limits.list("resource.limits")
+		if err != nil {
+			return v1.ResourceRequirements{}, err
+		}
+
+		for _, name := range sortedNames(explicit) {
+			q := explicit[name]
+			if _, ok := limits[name]; ok {
+				return v1.ResourceRequirements{}, &FieldError{
+					Field:  "resource.limits." + resourceField(name),
+					Reason: fmt.Sprintf("conflicts with resource.%s", resourceField(name)),
+				}
+			}
+			limits[name] = q
+		}
+	}
+
+	requests := v1.ResourceList{}
+	if r.Requests != nil {
+		if requests, err = r.Requests.list("resource.requests"); err != nil {
+			return v1.ResourceRequirements{}, err
+		}
+	}
+
+	for _, name := range sortedNames(requests) {
+		q := requests[name]
+		field := "resource.requests." + resourceField(name)
+		limit, ok := limits[name]
+
+		if overcommittable(name) {
+			if ok && q.Cmp(limit) > 0 {
+				return v1.ResourceRequirements{}, &FieldError{Field: field, Reason: fmt.Sprintf("%s exceeds the limit of %s", q.String(), limit.String())}
+			}
+			continue
+		}
+
+		if !ok || q.Cmp(limit) != 0 {
+			return v1.ResourceRequirements{}, &FieldError{Field: field, Reason: "must be equal to the limit"}
+		}
+	}
+
+	res := v1.ResourceRequirements{}
+	if len(limits) > 0 {
+		res.Limits = limits
+	}
+	if len(requests) > 0 {
+		res.Requests = requests
+	}
+
+	return res, nil
+}
+
+func (r Resource) Validate() error {
+	_, err := r.requirements()
+	return err
+}
+
+// resources renders r, which must have been validated, into container
+// resource requirements.
+func resources(r *Resource) v1.ResourceRequirements {
+	if r == nil {
+		return v1.ResourceRequirements{}
+	}
+
+	res, _ := r.requirements()
+	return res
+}
+
+func parseQuantity(list v1.ResourceList, name v1.ResourceName, field, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("%q is not a valid quantity", value)}
+	}
+
+	if q.Sign() < 0 {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("%q is negative", value)}
+	}
+
+	list[name] = q
+	return nil
+}
+
+func resourceField(name v1.ResourceName) string {
+	if field, ok := resourceFields[name]; ok {
+		return field
+	}
+
+	return "hugePages." + strings.TrimPrefix(string(name), v1.ResourceHugePagesPrefix)
+}
+
+// overcommittable reports whether requests of the resource may be lower than
+// its limit. GPUs and huge pages can't be overcommitted.
+func overcommittable(name v1.ResourceName) bool {
+	return name != gpuResource && !strings.HasPrefix(string(name), v1.ResourceHugePagesPrefix)
+}
+
+func sortedNames(list v1.ResourceList) []v1.ResourceName {
+	var names []v1.ResourceName
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	return names
+}
@@ -0,0 +1,384 @@
+package k8s_client
+
+import (
+	"strconv"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+var (
+	tolerationOperators = map[string]bool{"": true, "Equal": true, "Exists": true}
+
+	taintEffects = map[string]bool{
+		"":                 true,
+		"NoSchedule":       true,
+		"PreferNoSchedule": true,
+		"NoExecute":        true,
+	}
+
+	nodeSelectorOperators = map[string]bool{
+		"In":           true,
+		"NotIn":        true,
+		"Exists":       true,
+		"DoesNotExist": true,
+		"Gt":           true,
+		"Lt":           true,
+	}
+
+	labelSelectorOperators = map[string]bool{
+		"In":           true,
+		"NotIn":        true,
+		"Exists":       true,
+		"DoesNotExist": true,
+	}
+
+	selectionOperators = map[v1.NodeSelectorOperator]selection.Operator{
+		v1.NodeSelectorOpIn:           selection.In,
+		v1.NodeSelectorOpNotIn:        selection.NotIn,
+		v1.NodeSelectorOpExists:       selection.Exists,
+		v1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+		v1.NodeSelectorOpGt:           selection.GreaterThan,
+		v1.NodeSelectorOpLt:           selection.LessThan,
+	}
+)
+
+// Toleration allows pods onto nodes with a matching taint. An empty Operator
+// means Equal and an empty Effect matches every effect.
+type Toleration struct {
+	Key               string
+	Operator          string
+	Value             string
+	Effect            string
+	TolerationSeconds *int64
+}
+
+func (t Toleration) Validate() error {
+	if !tolerationOperators[t.Operator] || !taintEffects[t.Effect] {
+		return ErrMalformedEntity
+	}
+
+	if t.Operator == "Exists" && t.Value != "" {
+		return ErrMalformedEntity
+	}
+
+	if t.Key == "" && t.Operator != "Exists" {
+		return ErrMalformedEntity
+	}
+
+	if t.TolerationSeconds != nil && t.Effect != "NoExecute" {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// LabelRequirement matches the value of a node or pod label. In and NotIn
+// take one or more values, Gt and Lt a single integer and Exists and
+// DoesNotExist none.
+type LabelRequirement struct {
+	Key      string
+	Operator string
+	Values   []string
+}
+
+func (r LabelRequirement) validate(operators map[string]bool) error {
+	if r.Key == "" || !operators[r.Operator] {
+		return ErrMalformedEntity
+	}
+
+	switch r.Operator {
+	case "In", "NotIn":
+		if len(r.Values) == 0 {
+			return ErrMalformedEntity
+		}
+	case "Exists", "DoesNotExist":
+		if len(r.Values) != 0 {
+			return ErrMalformedEntity
+		}
+	case "Gt", "Lt":
+		if len(r.Values) != 1 {
+			return ErrMalformedEntity
+		}
+		if _, err := strconv.ParseInt(r.Values[0], 10, 64); err != nil {
+			return ErrMalformedEntity
+		}
+	}
+
+	return nil
+}
+
+// NodeSelectorTerm selects nodes whose labels meet all of its expressions.
+// A term without Weight is required, pods will only be scheduled on nodes
+// matching at least one required term. A term with a Weight between 1 and
+// 100 is a preference.
+type NodeSelectorTerm struct {
+	Weight           int32
+	MatchExpressions []*LabelRequirement
+}
+
+func (t NodeSelectorTerm) Validate() error {
+	if t.Weight < 0 || t.Weight > 100 || len(t.MatchExpressions) == 0 {
+		return ErrMalformedEntity
+	}
+
+	for _, r := range t.MatchExpressions {
+		if r == nil {
+			return ErrMalformedEntity
+		}
+		if err := r.validate(nodeSelectorOperators); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PodAffinityTerm selects the pods, in Namespaces or in the workload
+// namespace, that a workload should be co-located with or kept away from
+// within the same TopologyKey domain. Weight has the same meaning as on
+// NodeSelectorTerm.
+type PodAffinityTerm struct {
+	Weight           int32
+	MatchLabels      map[string]string
+	MatchExpressions []*LabelRequirement
+	Namespaces       []string
+	TopologyKey      string
+}
+
+func (t PodAffinityTerm) Validate() error {
+	if t.Weight < 0 || t.Weight > 100 || t.TopologyKey == "" {
+		return ErrMalformedEntity
+	}
+
+	if len(t.MatchLabels) == 0 && len(t.MatchExpressions) == 0 {
+		return ErrMalformedEntity
+	}
+
+	for _, r := range t.MatchExpressions {
+		if r == nil {
+			return ErrMalformedEntity
+		}
+		if err := r.validate(labelSelectorOperators); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Affinity constrains the nodes a workload runs on, by node labels and by
+// the pods already running there.
+type Affinity struct {
+	NodeAffinity    []*NodeSelectorTerm
+	PodAffinity     []*PodAffinityTerm
+	PodAntiAffinity []*PodAffinityTerm
+}
+
+func (a Affinity) Validate() error {
+	for _, t := range a.NodeAffinity {
+		if t == nil {
+			return ErrMalformedEntity
+		}
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, terms := range [][]*PodAffinityTerm{a.PodAffinity, a.PodAntiAffinity} {
+		for _, t := range terms {
+			if t == nil {
+				return ErrMalformedEntity
+			}
+			if err := t.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateScheduling(nodeSelector map[string]string, tolerations []*Toleration, affinity *Affinity) error {
+	for key := range nodeSelector {
+		if key == "" {
+			return ErrMalformedEntity
+		}
+	}
+
+	for _, t := range tolerations {
+		if t == nil {
+			return ErrMalformedEntity
+		}
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if affinity == nil {
+		return nil
+	}
+
+	return affinity.Validate()
+}
+
+func tolerations(list []*Toleration) []v1.Toleration {
+	var res []v1.Toleration
+	for _, t := range list {
+		if t == nil {
+			continue
+		}
+
+		res = append(res, v1.Toleration{
+			Key:               t.Key,
+			Operator:          v1.TolerationOperator(t.Operator),
+			Value:             t.Value,
+			Effect:            v1.TaintEffect(t.Effect),
+			TolerationSeconds: t.TolerationSeconds,
+		})
+	}
+
+	return res
+}
+
+func affinity(a *Affinity) *v1.Affinity {
+	if a == nil {
+		return nil
+	}
+
+	res := &v1.Affinity{}
+
+	if len(a.NodeAffinity) > 0 {
+		res.NodeAffinity = &v1.NodeAffinity{}
+		var required []v1.NodeSelectorTerm
+		for _, t := range a.NodeAffinity {
+			term := v1.NodeSelectorTerm{MatchExpressions: nodeSelectorRequirements(t.MatchExpressions)}
+			if t.Weight == 0 {
+				required = append(required, term)
+				continue
+			}
+			res.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+				res.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+				v1.PreferredSchedulingTerm{Weight: t.Weight, Preference: term},
+			)
+		}
+		if len(required) > 0 {
+			res.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &v1.NodeSelector{NodeSelectorTerms: required}
+		}
+	}
+
+	if len(a.PodAffinity) > 0 {
+		required, preferred := podAffinityTerms(a.PodAffinity)
+		res.PodAffinity = &v1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+
+	if len(a.PodAntiAffinity) > 0 {
+		required, preferred := podAffinityTerms(a.PodAntiAffinity)
+		res.PodAntiAffinity = &v1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+
+	return res
+}
+
+func nodeSelectorRequirements(list []*LabelRequirement) []v1.NodeSelectorRequirement {
+	var res []v1.NodeSelectorRequirement
+	for _, r := range list {
+		res = append(res, v1.NodeSelectorRequirement{
+			Key:      r.Key,
+			Operator: v1.NodeSelectorOperator(r.Operator),
+			Values:   r.Values,
+		})
+	}
+
+	return res
+}
+
+func podAffinityTerms(list []*PodAffinityTerm) ([]v1.PodAffinityTerm, []v1.WeightedPodAffinityTerm) {
+	var required []v1.PodAffinityTerm
+	var preferred []v1.WeightedPodAffinityTerm
+	for _, t := range list {
+		selector := &metav1.LabelSelector{MatchLabels: t.MatchLabels}
+		for _, r := range t.MatchExpressions {
+			selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+				Key:      r.Key,
+				Operator: metav1.LabelSelectorOperator(r.Operator),
+				Values:   r.Values,
+			})
+		}
+
+		term := v1.PodAffinityTerm{
+			LabelSelector: selector,
+			Namespaces:    t.Namespaces,
+			TopologyKey:   t.TopologyKey,
+		}
+		if t.Weight == 0 {
+			required = append(required, term)
+			continue
+		}
+		preferred = append(preferred, v1.WeightedPodAffinityTerm{Weight: t.Weight, PodAffinityTerm: term})
+	}
+
+	return required, preferred
+}
+
+// admits reports whether a pod with the given scheduling constraints may be
+// placed on node as far as labels and taints are concerned. Pod affinity is
+// not considered since it depends on where other pods land.
+func admits(node v1.Node, nodeSelector map[string]string, tolerations []v1.Toleration, affinity *v1.Affinity) bool {
+	if !labels.SelectorFromSet(nodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != v1.TaintEffectNoSchedule && taint.Effect != v1.TaintEffectNoExecute {
+			continue
+		}
+		if !tolerated(tolerations, taint) {
+			return false
+		}
+	}
+
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if matchesTerm(node, term) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func tolerated(tolerations []v1.Toleration, taint *v1.Taint) bool {
+	for _, t := range tolerations {
+		if t.ToleratesTaint(taint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesTerm(node v1.Node, term v1.NodeSelectorTerm) bool {
+	selector := labels.NewSelector()
+	for _, r := range term.MatchExpressions {
+		req, err := labels.NewRequirement(r.Key, selectionOperators[r.Operator], r.Values)
+		if err != nil {
+			return false
+		}
+		selector = selector.Add(*req)
+	}
+
+	return selector.Matches(labels.Set(node.Labels))
+}
@@ -8,127 +8,194 @@
 package k8s_client
 
 import (
-	"errors"
+	"context"
+	"strings"
+
+	"github.com/hykuan/k8s-client-example/auth"
+	"github.com/hykuan/k8s-client-example/errors"
 	"k8s.io/api/apps/v1"
+	jobv1 "k8s.io/api/batch/v1"
 	apiv1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	appv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
+	batchv1 "k8s.io/client-go/kubernetes/typed/batch/v1"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
 var (
-	// ErrConflict indicates usage of the existing email during account
-	// registration.
-	ErrConflict = errors.New("email already taken")
+	// ErrConflict indicates that an entity of the same name already exists.
+	ErrConflict = errors.New(errors.AlreadyExists, "entity already exists")
 
 	// ErrMalformedEntity indicates malformed entity specification (e.g.
 	// invalid username or password).
-	ErrMalformedEntity = errors.New("malformed entity specification")
+	ErrMalformedEntity = errors.New(errors.Invalid, "malformed entity specification")
 
 	// ErrUnauthorizedAccess indicates missing or invalid credentials provided
 	// when accessing a protected resource.
-	ErrUnauthorizedAccess = errors.New("missing or invalid credentials provided")
+	ErrUnauthorizedAccess = auth.ErrUnauthorizedAccess
 
 	// ErrNotFound indicates a non-existent entity request.
-	ErrNotFound = errors.New("non-existent entity")
+	ErrNotFound = errors.New(errors.NotFound, "non-existent entity")
 )
 
+// FieldError is a malformed entity specification caused by the value of a
+// single field, which it names.
+type FieldError = errors.FieldError
+
 // Service specifies an API that must be fullfiled by the domain service
 // implementation, and all of its decorators (e.g. logging & metrics).
+//
+// Watches and log streams end when ctx is done. The typed clients of this
+// client-go version take no context, so other calls only honor it where they
+// go through the REST client directly, such as dry runs.
 type Service interface {
-	CreateNFSPV(nfsPV NFSPersistentVolume) (string, error)
-	CreatePVC(pvc PersistentVolumeClaim) (string, error)
-	CreateDeployment(deployment Deployment) (string, error)
+	CreatePV(ctx context.Context, pv PersistentVolume, opts CreateOptions) (CreateResult, error)
+	CreateNFSPV(ctx context.Context, nfsPV NFSPersistentVolume, opts CreateOptions) (CreateResult, error)
+	CreatePVC(ctx context.Context, pvc PersistentVolumeClaim, opts CreateOptions) (CreateResult, error)
+	CreateDeployment(ctx context.Context, deployment Deployment, opts CreateOptions) (CreateResult, error)
+	GetPV(ctx context.Context, name string) (PersistentVolumeStatus, error)
+	ListPVs(ctx context.Context) ([]PersistentVolumeStatus, error)
+	GetPVC(ctx context.Context, namespace, name string) (PersistentVolumeClaimStatus, error)
+	ListPVCs(ctx context.Context, namespace string) ([]PersistentVolumeClaimStatus, error)
+	GetDeployment(ctx context.Context, namespace, name string) (DeploymentStatus, error)
+	ListDeployments(ctx context.Context, namespace string) ([]DeploymentStatus, error)
+	DeletePV(ctx context.Context, name string, opts DeleteOptions) error
+	DeletePVC(ctx context.Context, namespace, name string, opts DeleteOptions) error
+	DeleteDeployment(ctx context.Context, namespace, name string, opts DeleteOptions) error
+	UpdateDeployment(ctx context.Context, deployment Deployment) (string, error)
+	ScaleDeployment(ctx context.Context, namespace, name string, replicas int32) error
+	CreateJob(ctx context.Context, job Job, opts CreateOptions) (CreateResult, error)
+	WatchDeployment(ctx context.Context, namespace, name string) (<-chan WorkloadEvent, error)
+	WatchJob(ctx context.Context, namespace, name string) (<-chan WorkloadEvent, error)
+	StreamLogs(ctx context.Context, namespace, name string, opts LogOptions) (<-chan LogLine, error)
+	GetNodeMetrics(ctx context.Context) ([]NodeMetrics, error)
+	GetPodMetrics(ctx context.Context, namespace, name string) ([]PodMetrics, error)
+	ClusterCapacity(ctx context.Context) ([]NodeCapacity, error)
+	CanSchedule(ctx context.Context, deployment Deployment) (ScheduleResult, error)
+	CreateRegistryCredential(ctx context.Context, cred RegistryCredential, opts CreateOptions) (CreateResult, error)
+	ListRegistryCredentials(ctx context.Context, namespace string) ([]RegistryCredentialStatus, error)
+	DeleteRegistryCredential(ctx context.Context, namespace, name string, opts DeleteOptions) error
+	ExposeDeployment(ctx context.Context, exposure Exposure) (string, error)
+	CreateConfigMap(ctx context.Context, cm ConfigMap, opts CreateOptions) (CreateResult, error)
+	GetConfigMap(ctx context.Context, namespace, name string) (ConfigMap, error)
+	UpdateConfigMap(ctx context.Context, cm ConfigMap) (string, error)
+	DeleteConfigMap(ctx context.Context, namespace, name string, opts DeleteOptions) error
+	CreateSecret(ctx context.Context, secret Secret, opts CreateOptions) (CreateResult, error)
+	GetSecret(ctx context.Context, namespace, name string) (SecretStatus, error)
+	UpdateSecret(ctx context.Context, secret Secret) (string, error)
+	DeleteSecret(ctx context.Context, namespace, name string, opts DeleteOptions) error
+	CreateProject(ctx context.Context, project Project) (string, error)
+	GetProject(ctx context.Context, name string) (ProjectStatus, error)
+	UpdateProject(ctx context.Context, project Project) (string, error)
+	DeleteProject(ctx context.Context, name string, opts DeleteOptions) error
 }
 
 var _ Service = (*k8sClientService)(nil)
 
 type k8sClientService struct {
-	clientSet         *kubernetes.Clientset
-	pvClient          corev1.PersistentVolumeInterface
-	pvcClient         corev1.PersistentVolumeClaimInterface
-	deploymentsClient appv1.DeploymentInterface
+	clientSet        kubernetes.Interface
+	metricsClient    metrics.Interface
+	pvClient         corev1.PersistentVolumeInterface
+	defaultNamespace string
 }
 
-// New instantiates the users service implementation.
-func New(clientSet *kubernetes.Clientset) Service {
+// New instantiates the users service implementation. Namespaced resources
+// requested without an explicit namespace are created in defaultNamespace,
+// which falls back to the Kubernetes "default" namespace when empty. Usage
+// metrics are read from metrics-server through metricsClient.
+func New(clientSet kubernetes.Interface, metricsClient metrics.Interface, defaultNamespace string) Service {
+	if defaultNamespace == "" {
+		defaultNamespace = apiv1.NamespaceDefault
+	}
+
 	return &k8sClientService{
-		clientSet:         clientSet,
-		pvClient:          clientSet.CoreV1().PersistentVolumes(),
-		pvcClient:         clientSet.CoreV1().PersistentVolumeClaims(apiv1.NamespaceDefault),
-		deploymentsClient: clientSet.AppsV1().Deployments(apiv1.NamespaceDefault),
+		clientSet:        clientSet,
+		metricsClient:    metricsClient,
+		pvClient:         clientSet.CoreV1().PersistentVolumes(),
+		defaultNamespace: defaultNamespace,
 	}
 }
 
-func (svc k8sClientService) CreateNFSPV(nfsPV NFSPersistentVolume) (string, error) {
-	storage, err := resource.ParseQuantity(nfsPV.Storage)
+func (svc k8sClientService) namespace(namespace string) string {
+	if namespace == "" {
+		return svc.defaultNamespace
+	}
+
+	return namespace
+}
+
+func (svc k8sClientService) pvcClient(namespace string) corev1.PersistentVolumeClaimInterface {
+	return svc.clientSet.CoreV1().PersistentVolumeClaims(svc.namespace(namespace))
+}
+
+func (svc k8sClientService) deploymentsClient(namespace string) appv1.DeploymentInterface {
+	return svc.clientSet.AppsV1().Deployments(svc.namespace(namespace))
+}
+
+func (svc k8sClientService) jobsClient(namespace string) batchv1.JobInterface {
+	return svc.clientSet.BatchV1().Jobs(svc.namespace(namespace))
+}
+
+func (svc k8sClientService) CreateNFSPV(ctx context.Context, nfsPV NFSPersistentVolume, opts CreateOptions) (CreateResult, error) {
+	return svc.CreatePV(ctx, nfsPV.PersistentVolume(), opts)
+}
+
+func (svc k8sClientService) CreatePV(ctx context.Context, persistentVolume PersistentVolume, opts CreateOptions) (CreateResult, error) {
+	spec, err := persistentVolume.spec()
 	if err != nil {
-		return "", err
+		return CreateResult{}, err
 	}
 
-	pv, err := svc.pvClient.Create(&apiv1.PersistentVolume{
+	pv := &apiv1.PersistentVolume{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: nfsPV.Name,
-		},
-		Spec: apiv1.PersistentVolumeSpec{
-			Capacity: apiv1.ResourceList{
-				"storage": storage,
-			},
-			AccessModes: []apiv1.PersistentVolumeAccessMode{
-				apiv1.ReadWriteOnce,
-			},
-			PersistentVolumeSource: apiv1.PersistentVolumeSource{
-				NFS: &apiv1.NFSVolumeSource{
-					Server: nfsPV.Server,
-					Path:   nfsPV.Path,
-				},
-			},
+			Name:   persistentVolume.Name,
+			Labels: persistentVolume.Labels,
 		},
-	})
+		Spec: spec,
+	}
 
-	if err != nil {
-		return "", err
+	create := func() error {
+		_, err := svc.pvClient.Create(pv)
+		return err
 	}
 
-	return pv.Name, nil
+	return svc.create(ctx, opts, svc.clientSet.CoreV1().RESTClient(), "persistentvolumes", "", pv, create, svc.applyPV(pv))
 }
 
-func (svc k8sClientService) CreatePVC(pvc PersistentVolumeClaim) (string, error) {
-	volumeMode := apiv1.PersistentVolumeBlock
-	storage, err := resource.ParseQuantity(pvc.Storage)
+func (svc k8sClientService) CreatePVC(ctx context.Context, pvc PersistentVolumeClaim, opts CreateOptions) (CreateResult, error) {
+	spec, err := pvc.spec()
 	if err != nil {
-		return "", err
+		return CreateResult{}, err
 	}
 
-	pvClaim, err := svc.pvcClient.Create(&apiv1.PersistentVolumeClaim{
+	pvClaim := &apiv1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: pvc.Name,
 		},
-		Spec: apiv1.PersistentVolumeClaimSpec{
-			AccessModes: []apiv1.PersistentVolumeAccessMode{
-				apiv1.ReadWriteOnce,
-			},
-			VolumeMode: &volumeMode,
-			Resources: apiv1.ResourceRequirements{
-				Requests: apiv1.ResourceList{
-					"storage": storage,
-				},
-			},
-		},
-	})
-	if err != nil {
-		return "", err
+		Spec: spec,
 	}
 
-	return pvClaim.Name, nil
+	create := func() error {
+		_, err := svc.pvcClient(pvc.Namespace).Create(pvClaim)
+		return err
+	}
+
+	return svc.create(ctx, opts, svc.clientSet.CoreV1().RESTClient(), "persistentvolumeclaims", svc.namespace(pvc.Namespace), pvClaim, create, svc.applyPVC(pvc.Namespace, pvClaim))
 }
 
-func (svc k8sClientService) CreateDeployment(deployment Deployment) (string, error) {
+func (svc k8sClientService) CreateDeployment(ctx context.Context, deployment Deployment, opts CreateOptions) (CreateResult, error) {
 	deployment.AssignDefaultValue()
 
-	d, err := svc.deploymentsClient.Create(&v1.Deployment{
+	pullSecrets, err := svc.imagePullSecrets(deployment.Namespace, deployment.Image, deployment.ImagePullSecrets)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	d := &v1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: deployment.Name,
 		},
@@ -148,24 +215,316 @@ func (svc k8sClientService) CreateDeployment(deployment Deployment) (string, err
 				Spec: apiv1.PodSpec{
 					Containers: []apiv1.Container{
 						{
-							Name:  deployment.Name,
-							Image: deployment.Image,
-							Resources: apiv1.ResourceRequirements{
-								Limits: deployment.GetResourceList(),
-							},
+							Name:         deployment.Name,
+							Image:        deployment.Image,
+							Resources:    deployment.GetResources(),
 							VolumeMounts: deployment.GetVolumeMounts(),
 							Command:      deployment.Command,
 							Args:         deployment.Arguments,
+							Env:          deployment.GetEnv(),
+							EnvFrom:      deployment.GetEnvFrom(),
+							Ports:        deployment.GetPorts(),
 						},
 					},
-					Volumes: deployment.GetVolumes(),
+					Volumes:           deployment.GetVolumes(),
+					ImagePullSecrets:  pullSecrets,
+					NodeSelector:      deployment.NodeSelector,
+					Tolerations:       deployment.GetTolerations(),
+					Affinity:          deployment.GetAffinity(),
+					PriorityClassName: deployment.PriorityClassName,
+					SchedulerName:     deployment.SchedulerName,
 				},
 			},
 		},
-	})
+	}
+
+	create := func() error {
+		_, err := svc.deploymentsClient(deployment.Namespace).Create(d)
+		return err
+	}
+
+	return svc.create(ctx, opts, svc.clientSet.AppsV1().RESTClient(), "deployments", svc.namespace(deployment.Namespace), d, create, svc.applyDeployment(deployment.Namespace, d))
+}
+
+func (svc k8sClientService) CreateJob(ctx context.Context, job Job, opts CreateOptions) (CreateResult, error) {
+	pullSecrets, err := svc.imagePullSecrets(job.Namespace, job.Image, job.ImagePullSecrets)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	j := &jobv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: job.Name,
+		},
+		Spec: jobv1.JobSpec{
+			BackoffLimit:            job.BackoffLimit,
+			ActiveDeadlineSeconds:   job.ActiveDeadlineSeconds,
+			Completions:             job.Completions,
+			Parallelism:             job.Parallelism,
+			TTLSecondsAfterFinished: job.TTLSecondsAfterFinished,
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": job.Name,
+					},
+				},
+				Spec: apiv1.PodSpec{
+					RestartPolicy: apiv1.RestartPolicyNever,
+					Containers: []apiv1.Container{
+						{
+							Name:         job.Name,
+							Image:        job.Image,
+							Resources:    job.GetResources(),
+							VolumeMounts: job.GetVolumeMounts(),
+							Command:      job.Command,
+							Args:         job.Arguments,
+							Env:          job.GetEnv(),
+							EnvFrom:      job.GetEnvFrom(),
+						},
+					},
+					Volumes:           job.GetVolumes(),
+					ImagePullSecrets:  pullSecrets,
+					NodeSelector:      job.NodeSelector,
+					Tolerations:       job.GetTolerations(),
+					Affinity:          job.GetAffinity(),
+					PriorityClassName: job.PriorityClassName,
+					SchedulerName:     job.SchedulerName,
+				},
+			},
+		},
+	}
+
+	create := func() error {
+		_, err := svc.jobsClient(job.Namespace).Create(j)
+		return err
+	}
+
+	return svc.create(ctx, opts, svc.clientSet.BatchV1().RESTClient(), "jobs", svc.namespace(job.Namespace), j, create, svc.applyJob(job.Namespace, j))
+}
+
+func (svc k8sClientService) GetPV(ctx context.Context, name string) (PersistentVolumeStatus, error) {
+	pv, err := svc.pvClient.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return PersistentVolumeStatus{}, translateError(err)
+	}
+
+	return toPVStatus(*pv), nil
+}
+
+func (svc k8sClientService) ListPVs(ctx context.Context) ([]PersistentVolumeStatus, error) {
+	list, err := svc.pvClient.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	pvs := []PersistentVolumeStatus{}
+	for _, pv := range list.Items {
+		pvs = append(pvs, toPVStatus(pv))
+	}
+
+	return pvs, nil
+}
+
+func (svc k8sClientService) GetPVC(ctx context.Context, namespace, name string) (PersistentVolumeClaimStatus, error) {
+	pvc, err := svc.pvcClient(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return PersistentVolumeClaimStatus{}, translateError(err)
+	}
+
+	return toPVCStatus(*pvc), nil
+}
+
+func (svc k8sClientService) ListPVCs(ctx context.Context, namespace string) ([]PersistentVolumeClaimStatus, error) {
+	list, err := svc.pvcClient(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	pvcs := []PersistentVolumeClaimStatus{}
+	for _, pvc := range list.Items {
+		pvcs = append(pvcs, toPVCStatus(pvc))
+	}
+
+	return pvcs, nil
+}
+
+func (svc k8sClientService) GetDeployment(ctx context.Context, namespace, name string) (DeploymentStatus, error) {
+	d, err := svc.deploymentsClient(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return DeploymentStatus{}, translateError(err)
+	}
+
+	return toDeploymentStatus(*d), nil
+}
+
+func (svc k8sClientService) ListDeployments(ctx context.Context, namespace string) ([]DeploymentStatus, error) {
+	list, err := svc.deploymentsClient(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	deployments := []DeploymentStatus{}
+	for _, d := range list.Items {
+		deployments = append(deployments, toDeploymentStatus(d))
+	}
+
+	return deployments, nil
+}
+
+func (svc k8sClientService) DeletePV(ctx context.Context, name string, opts DeleteOptions) error {
+	return translateError(svc.pvClient.Delete(name, opts.toDeleteOptions()))
+}
+
+func (svc k8sClientService) DeletePVC(ctx context.Context, namespace, name string, opts DeleteOptions) error {
+	return translateError(svc.pvcClient(namespace).Delete(name, opts.toDeleteOptions()))
+}
+
+func (svc k8sClientService) DeleteDeployment(ctx context.Context, namespace, name string, opts DeleteOptions) error {
+	return translateError(svc.deploymentsClient(namespace).Delete(name, opts.toDeleteOptions()))
+}
+
+func (svc k8sClientService) UpdateDeployment(ctx context.Context, deployment Deployment) (string, error) {
+	client := svc.deploymentsClient(deployment.Namespace)
+
+	pullSecrets, err := svc.imagePullSecrets(deployment.Namespace, deployment.Image, deployment.ImagePullSecrets)
 	if err != nil {
 		return "", err
 	}
 
-	return d.Name, nil
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		d, err := client.Get(deployment.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := deployment.Patch(d); err != nil {
+			return err
+		}
+
+		if len(pullSecrets) > 0 {
+			d.Spec.Template.Spec.ImagePullSecrets = pullSecrets
+		}
+
+		_, err = client.Update(d)
+		return err
+	})
+	if err != nil {
+		return "", translateError(err)
+	}
+
+	return deployment.Name, nil
+}
+
+func (svc k8sClientService) ScaleDeployment(ctx context.Context, namespace, name string, replicas int32) error {
+	client := svc.deploymentsClient(namespace)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		scale, err := client.GetScale(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		scale.Spec.Replicas = replicas
+		_, err = client.UpdateScale(name, scale)
+		return err
+	})
+
+	return translateError(err)
+}
+
+// translateError maps the errors returned by the API server onto the service
+// errors that the transport layers know how to encode. Other errors are
+// returned as is.
+func translateError(err error) error {
+	statusErr, ok := err.(k8sErrors.APIStatus)
+	if !ok {
+		return err
+	}
+	status := statusErr.Status()
+
+	switch {
+	case k8sErrors.IsNotFound(err):
+		return ErrNotFound
+	case k8sErrors.IsAlreadyExists(err):
+		return ErrConflict
+	case k8sErrors.IsInvalid(err), k8sErrors.IsBadRequest(err):
+		e := errors.New(errors.Invalid, ErrMalformedEntity.Message)
+		if status.Details != nil {
+			for _, cause := range status.Details.Causes {
+				e.Fields = append(e.Fields, errors.FieldViolation{Field: cause.Field, Reason: cause.Message})
+			}
+		}
+		if len(e.Fields) == 0 {
+			e.Message = status.Message
+		}
+		return e
+	case k8sErrors.IsForbidden(err) && strings.Contains(status.Message, "exceeded quota"):
+		return errors.New(errors.QuotaExceeded, status.Message)
+	case k8sErrors.IsForbidden(err):
+		return errors.New(errors.Forbidden, status.Message)
+	case k8sErrors.IsServiceUnavailable(err), k8sErrors.IsServerTimeout(err),
+		k8sErrors.IsTimeout(err), k8sErrors.IsTooManyRequests(err):
+		return errors.New(errors.Unavailable, "cluster unavailable")
+	}
+
+	return err
+}
+
+func toPVStatus(pv apiv1.PersistentVolume) PersistentVolumeStatus {
+	status := PersistentVolumeStatus{
+		Name:   pv.Name,
+		Phase:  string(pv.Status.Phase),
+		Reason: pv.Status.Reason,
+	}
+
+	if storage, ok := pv.Spec.Capacity[apiv1.ResourceStorage]; ok {
+		status.Storage = storage.String()
+	}
+
+	if ref := pv.Spec.ClaimRef; ref != nil {
+		status.ClaimNamespace = ref.Namespace
+		status.ClaimName = ref.Name
+	}
+
+	return status
+}
+
+func toPVCStatus(pvc apiv1.PersistentVolumeClaim) PersistentVolumeClaimStatus {
+	status := PersistentVolumeClaimStatus{
+		Name:       pvc.Name,
+		Namespace:  pvc.Namespace,
+		Phase:      string(pvc.Status.Phase),
+		VolumeName: pvc.Spec.VolumeName,
+	}
+
+	if storage, ok := pvc.Spec.Resources.Requests[apiv1.ResourceStorage]; ok {
+		status.Storage = storage.String()
+	}
+
+	if capacity, ok := pvc.Status.Capacity[apiv1.ResourceStorage]; ok {
+		status.Capacity = capacity.String()
+	}
+
+	return status
+}
+
+func toDeploymentStatus(d v1.Deployment) DeploymentStatus {
+	status := DeploymentStatus{
+		Name:                d.Name,
+		Namespace:           d.Namespace,
+		UpdatedReplicas:     d.Status.UpdatedReplicas,
+		ReadyReplicas:       d.Status.ReadyReplicas,
+		AvailableReplicas:   d.Status.AvailableReplicas,
+		UnavailableReplicas: d.Status.UnavailableReplicas,
+	}
+
+	if d.Spec.Replicas != nil {
+		status.Replicas = *d.Spec.Replicas
+	}
+
+	if containers := d.Spec.Template.Spec.Containers; len(containers) > 0 {
+		status.Image = containers[0].Image
+	}
+
+	return status
 }
@@ -0,0 +1,1955 @@
+package k8s_client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hykuan/k8s-client-example/errors"
+	"github.com/hykuan/k8s-client-example/k8s-client"
+	"github.com/hykuan/k8s-client-example/k8s-client/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+)
+
+const (
+	namespace = "training"
+	name      = "mnist"
+	image     = "tensorflow/tensorflow:latest-gpu"
+)
+
+var volumes = []*k8s_client.VolumeInfo{
+	{Name: "dataset", PVCName: "dataset-pvc", MountPath: "/data"},
+	{Name: "model", PVCName: "model-pvc", MountPath: "/model"},
+}
+
+func TestCreateNFSPV(t *testing.T) {
+	cases := map[string]struct {
+		pv  k8s_client.NFSPersistentVolume
+		err bool
+	}{
+		"create nfs pv":                      {k8s_client.NFSPersistentVolume{Name: "nfs", Storage: "10Gi", Server: "10.0.0.1", Path: "/exports"}, false},
+		"create nfs pv with bad storage":     {k8s_client.NFSPersistentVolume{Name: "nfs", Storage: "ten", Server: "10.0.0.1", Path: "/exports"}, true},
+		"create nfs pv with bad access mode": {k8s_client.NFSPersistentVolume{Name: "nfs", Storage: "10Gi", Server: "10.0.0.1", Path: "/exports", AccessModes: []string{"RWM"}}, true},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace)
+		pvRes, err := h.Service.CreateNFSPV(context.Background(), tc.pv, k8s_client.CreateOptions{})
+		assert.Equal(t, tc.err, err != nil, fmt.Sprintf("%s: unexpected error %v", desc, err))
+		if tc.err {
+			continue
+		}
+
+		pv, err := h.ClientSet.CoreV1().PersistentVolumes().Get(pvRes.Name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, resource.MustParse(tc.pv.Storage), pv.Spec.Capacity[apiv1.ResourceStorage], fmt.Sprintf("%s: wrong capacity", desc))
+		assert.Equal(t, tc.pv.Server, pv.Spec.NFS.Server, fmt.Sprintf("%s: wrong nfs server", desc))
+		assert.Equal(t, tc.pv.Path, pv.Spec.NFS.Path, fmt.Sprintf("%s: wrong nfs path", desc))
+	}
+}
+
+func TestCreatePVBindsClaim(t *testing.T) {
+	claim := k8s_client.PersistentVolumeClaim{
+		Name:             "dataset-pvc",
+		Storage:          "5Gi",
+		StorageClassName: "datasets",
+		AccessModes:      []string{"RWX"},
+		Selector:         map[string]string{"dataset": "mnist"},
+	}
+	shared := k8s_client.NFSPersistentVolume{
+		Name:             "nfs",
+		Storage:          "10Gi",
+		Server:           "10.0.0.1",
+		Path:             "/exports",
+		AccessModes:      []string{"ReadWriteMany", "ReadOnlyMany"},
+		StorageClassName: "datasets",
+		Labels:           map[string]string{"dataset": "mnist"},
+	}
+	exclusive := shared
+	exclusive.AccessModes = nil
+
+	cases := map[string]struct {
+		pv    k8s_client.NFSPersistentVolume
+		binds bool
+	}{
+		"bind rwx claim to rwx pv": {shared, true},
+		"bind rwx claim to rwo pv": {exclusive, false},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace)
+		pvRes, err := h.Service.CreateNFSPV(context.Background(), tc.pv, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		pvcRes, err := h.Service.CreatePVC(context.Background(), claim, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		pv, err := h.ClientSet.CoreV1().PersistentVolumes().Get(pvRes.Name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		pvc, err := h.ClientSet.CoreV1().PersistentVolumeClaims(namespace).Get(pvcRes.Name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		assert.Equal(t, tc.binds, bindable(t, pv, pvc), fmt.Sprintf("%s: expected binds %t", desc, tc.binds))
+	}
+}
+
+// bindable mirrors the checks of the PersistentVolume controller before it
+// binds a claim to a volume.
+func bindable(t *testing.T, pv *apiv1.PersistentVolume, pvc *apiv1.PersistentVolumeClaim) bool {
+	if pvc.Spec.StorageClassName == nil || pv.Spec.StorageClassName != *pvc.Spec.StorageClassName {
+		return false
+	}
+
+	capacity := pv.Spec.Capacity[apiv1.ResourceStorage]
+	if capacity.Cmp(pvc.Spec.Resources.Requests[apiv1.ResourceStorage]) < 0 {
+		return false
+	}
+
+	if pvc.Spec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(pvc.Spec.Selector)
+		require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+		if !selector.Matches(labels.Set(pv.Labels)) {
+			return false
+		}
+	}
+
+	modes := map[apiv1.PersistentVolumeAccessMode]bool{}
+	for _, mode := range pv.Spec.AccessModes {
+		modes[mode] = true
+	}
+	for _, mode := range pvc.Spec.AccessModes {
+		if !modes[mode] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestCreatePV(t *testing.T) {
+	cases := map[string]struct {
+		source k8s_client.VolumeSource
+		err    error
+		check  func(apiv1.PersistentVolumeSpec) bool
+	}{
+		"create hostPath pv": {
+			source: k8s_client.VolumeSource{HostPath: &k8s_client.HostPathVolumeSource{Path: "/mnt/data", Type: "Directory"}},
+			check:  func(s apiv1.PersistentVolumeSpec) bool { return s.HostPath.Path == "/mnt/data" },
+		},
+		"create cephfs pv": {
+			source: k8s_client.VolumeSource{CephFS: &k8s_client.CephFSVolumeSource{Monitors: []string{"10.0.0.1:6789"}, SecretName: "ceph"}},
+			check:  func(s apiv1.PersistentVolumeSpec) bool { return s.CephFS.SecretRef.Name == "ceph" },
+		},
+		"create iscsi pv": {
+			source: k8s_client.VolumeSource{ISCSI: &k8s_client.ISCSIVolumeSource{TargetPortal: "10.0.0.2:3260", IQN: "iqn.2020-01.io.example:disk", Lun: 1}},
+			check:  func(s apiv1.PersistentVolumeSpec) bool { return s.ISCSI.Lun == 1 },
+		},
+		"create local pv": {
+			source: k8s_client.VolumeSource{Local: &k8s_client.LocalVolumeSource{Path: "/mnt/ssd", Nodes: []string{"gpu-node"}}},
+			check: func(s apiv1.PersistentVolumeSpec) bool {
+				return s.NodeAffinity.Required.NodeSelectorTerms[0].MatchExpressions[0].Values[0] == "gpu-node"
+			},
+		},
+		"create csi pv": {
+			source: k8s_client.VolumeSource{CSI: &k8s_client.CSIVolumeSource{Driver: "cephfs.csi.ceph.com", VolumeHandle: "vol-1", Attributes: map[string]string{"pool": "data"}}},
+			check:  func(s apiv1.PersistentVolumeSpec) bool { return s.CSI.VolumeAttributes["pool"] == "data" },
+		},
+		"create pv without source": {
+			source: k8s_client.VolumeSource{},
+			err:    k8s_client.ErrMalformedEntity,
+		},
+		"create pv with two sources": {
+			source: k8s_client.VolumeSource{
+				NFS:      &k8s_client.NFSVolumeSource{Server: "10.0.0.1", Path: "/exports"},
+				HostPath: &k8s_client.HostPathVolumeSource{Path: "/mnt/data"},
+			},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create local pv without nodes": {
+			source: k8s_client.VolumeSource{Local: &k8s_client.LocalVolumeSource{Path: "/mnt/ssd"}},
+			err:    k8s_client.ErrMalformedEntity,
+		},
+		"create csi pv without volume handle": {
+			source: k8s_client.VolumeSource{CSI: &k8s_client.CSIVolumeSource{Driver: "cephfs.csi.ceph.com"}},
+			err:    k8s_client.ErrMalformedEntity,
+		},
+	}
+
+	for desc, tc := range cases {
+		pv := k8s_client.PersistentVolume{Name: "pv", Storage: "1Ti", Source: tc.source}
+		err := pv.Validate()
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+		if tc.err != nil {
+			continue
+		}
+
+		h := mocks.NewHarness(namespace)
+		pvRes, err := h.Service.CreatePV(context.Background(), pv, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		created, err := h.ClientSet.CoreV1().PersistentVolumes().Get(pvRes.Name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.True(t, tc.check(created.Spec), fmt.Sprintf("%s: wrong volume source %+v", desc, created.Spec.PersistentVolumeSource))
+	}
+}
+
+func TestCreatePVC(t *testing.T) {
+	cases := map[string]struct {
+		pvc       k8s_client.PersistentVolumeClaim
+		namespace string
+	}{
+		"create pvc in default namespace":  {k8s_client.PersistentVolumeClaim{Name: "dataset-pvc", Storage: "5Gi"}, namespace},
+		"create pvc in explicit namespace": {k8s_client.PersistentVolumeClaim{Name: "dataset-pvc", Namespace: "other", Storage: "5Gi"}, "other"},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace)
+		pvcRes, err := h.Service.CreatePVC(context.Background(), tc.pvc, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		pvc, err := h.ClientSet.CoreV1().PersistentVolumeClaims(tc.namespace).Get(pvcRes.Name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, resource.MustParse(tc.pvc.Storage), pvc.Spec.Resources.Requests[apiv1.ResourceStorage], fmt.Sprintf("%s: wrong storage request", desc))
+		assert.Equal(t, []apiv1.PersistentVolumeAccessMode{apiv1.ReadWriteOnce}, pvc.Spec.AccessModes, fmt.Sprintf("%s: wrong default access modes", desc))
+		assert.Equal(t, apiv1.PersistentVolumeFilesystem, *pvc.Spec.VolumeMode, fmt.Sprintf("%s: wrong default volume mode", desc))
+	}
+}
+
+func TestCreatePVCSpec(t *testing.T) {
+	cases := map[string]struct {
+		pvc   k8s_client.PersistentVolumeClaim
+		err   error
+		check func(apiv1.PersistentVolumeClaimSpec) bool
+	}{
+		"create shared pvc": {
+			pvc: k8s_client.PersistentVolumeClaim{Name: "dataset", Storage: "1Ti", StorageClassName: "cephfs", AccessModes: []string{"RWX", "ReadOnlyMany"}},
+			check: func(s apiv1.PersistentVolumeClaimSpec) bool {
+				return *s.StorageClassName == "cephfs" && len(s.AccessModes) == 2 && s.AccessModes[0] == apiv1.ReadWriteMany
+			},
+		},
+		"create block pvc": {
+			pvc:   k8s_client.PersistentVolumeClaim{Name: "disk", Storage: "10Gi", VolumeMode: "Block"},
+			check: func(s apiv1.PersistentVolumeClaimSpec) bool { return *s.VolumeMode == apiv1.PersistentVolumeBlock },
+		},
+		"create pvc bound to volume": {
+			pvc:   k8s_client.PersistentVolumeClaim{Name: "dataset", Storage: "1Ti", VolumeName: "nfs"},
+			check: func(s apiv1.PersistentVolumeClaimSpec) bool { return s.VolumeName == "nfs" },
+		},
+		"create pvc with selector": {
+			pvc:   k8s_client.PersistentVolumeClaim{Name: "dataset", Storage: "1Ti", Selector: map[string]string{"tier": "ssd"}},
+			check: func(s apiv1.PersistentVolumeClaimSpec) bool { return s.Selector.MatchLabels["tier"] == "ssd" },
+		},
+		"create pvc with unknown access mode": {
+			pvc: k8s_client.PersistentVolumeClaim{Name: "dataset", Storage: "1Ti", AccessModes: []string{"ReadWriteSometimes"}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create pvc with duplicate access mode": {
+			pvc: k8s_client.PersistentVolumeClaim{Name: "dataset", Storage: "1Ti", AccessModes: []string{"RWX", "ReadWriteMany"}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create pvc with unknown volume mode": {
+			pvc: k8s_client.PersistentVolumeClaim{Name: "dataset", Storage: "1Ti", VolumeMode: "Object"},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create pvc with volume name and selector": {
+			pvc: k8s_client.PersistentVolumeClaim{Name: "dataset", Storage: "1Ti", VolumeName: "nfs", Selector: map[string]string{"tier": "ssd"}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+	}
+
+	for desc, tc := range cases {
+		err := tc.pvc.Validate()
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+		if tc.err != nil {
+			continue
+		}
+
+		h := mocks.NewHarness(namespace)
+		pvcRes, err := h.Service.CreatePVC(context.Background(), tc.pvc, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		pvc, err := h.ClientSet.CoreV1().PersistentVolumeClaims(namespace).Get(pvcRes.Name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.True(t, tc.check(pvc.Spec), fmt.Sprintf("%s: wrong claim spec %+v", desc, pvc.Spec))
+	}
+}
+
+func TestCreateDeployment(t *testing.T) {
+	cases := map[string]struct {
+		deployment k8s_client.Deployment
+		replicas   int32
+	}{
+		"create deployment with default replicas": {
+			deployment: k8s_client.Deployment{Name: name, Image: image},
+			replicas:   1,
+		},
+		"create deployment with replicas, resources and volumes": {
+			deployment: k8s_client.Deployment{
+				Name:     name,
+				Replicas: 3,
+				Image:    image,
+				Resource: &k8s_client.Resource{CPU: "2", Memory: "4Gi", GPU: "1"},
+				Volumes:  volumes,
+			},
+			replicas: 3,
+		},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace)
+		deploymentRes, err := h.Service.CreateDeployment(context.Background(), tc.deployment, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		d, err := h.ClientSet.AppsV1().Deployments(namespace).Get(deploymentRes.Name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, tc.replicas, *d.Spec.Replicas, fmt.Sprintf("%s: wrong replicas", desc))
+		assert.Equal(t, map[string]string{"app": name}, d.Spec.Selector.MatchLabels, fmt.Sprintf("%s: wrong selector", desc))
+		assert.Equal(t, map[string]string{"app": name}, d.Spec.Template.Labels, fmt.Sprintf("%s: wrong pod labels", desc))
+		assertPodSpec(t, desc, tc.deployment.Resource, tc.deployment.Volumes, d.Spec.Template.Spec)
+	}
+}
+
+func TestCreateJob(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	backoffLimit := int32(2)
+
+	jobRes, err := h.Service.CreateJob(context.Background(), k8s_client.Job{
+		Name:         name,
+		Image:        image,
+		Resource:     &k8s_client.Resource{GPU: "2"},
+		Volumes:      volumes,
+		BackoffLimit: &backoffLimit,
+	}, k8s_client.CreateOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	job, err := h.ClientSet.BatchV1().Jobs(namespace).Get(jobRes.Name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, backoffLimit, *job.Spec.BackoffLimit, "wrong backoff limit")
+	assert.Equal(t, map[string]string{"app": name}, job.Spec.Template.Labels, "wrong pod labels")
+	assert.Equal(t, apiv1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy, "wrong restart policy")
+	assertPodSpec(t, "create job", &k8s_client.Resource{GPU: "2"}, volumes, job.Spec.Template.Spec)
+}
+
+func TestCreateDeploymentResources(t *testing.T) {
+	cases := map[string]struct {
+		resource k8s_client.Resource
+		limits   apiv1.ResourceList
+		requests apiv1.ResourceList
+		field    string
+	}{
+		"create deployment with shorthand limits": {
+			resource: k8s_client.Resource{CPU: "2", Memory: "4Gi"},
+			limits:   apiv1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("4Gi")},
+		},
+		"create deployment with requests and limits": {
+			resource: k8s_client.Resource{
+				Requests: &k8s_client.ResourceQuantities{CPU: "500m", Memory: "1Gi", GPU: "1", HugePages: map[string]string{"2Mi": "128Mi"}},
+				Limits:   &k8s_client.ResourceQuantities{CPU: "2", GPU: "1", EphemeralStorage: "10Gi", HugePages: map[string]string{"2Mi": "128Mi"}},
+			},
+			limits: apiv1.ResourceList{
+				"cpu":               resource.MustParse("2"),
+				"nvidia.com/gpu":    resource.MustParse("1"),
+				"ephemeral-storage": resource.MustParse("10Gi"),
+				"hugepages-2Mi":     resource.MustParse("128Mi"),
+			},
+			requests: apiv1.ResourceList{
+				"cpu":            resource.MustParse("500m"),
+				"memory":         resource.MustParse("1Gi"),
+				"nvidia.com/gpu": resource.MustParse("1"),
+				"hugepages-2Mi":  resource.MustParse("128Mi"),
+			},
+		},
+		"create deployment with trailing space in memory": {
+			resource: k8s_client.Resource{Memory: "4Gi "},
+			field:    "resource.memory",
+		},
+		"create deployment with invalid limit": {
+			resource: k8s_client.Resource{Limits: &k8s_client.ResourceQuantities{EphemeralStorage: "ten"}},
+			field:    "resource.limits.ephemeralStorage",
+		},
+		"create deployment with negative request": {
+			resource: k8s_client.Resource{Requests: &k8s_client.ResourceQuantities{CPU: "-1"}},
+			field:    "resource.requests.cpu",
+		},
+		"create deployment with request above limit": {
+			resource: k8s_client.Resource{CPU: "1", Requests: &k8s_client.ResourceQuantities{CPU: "2"}},
+			field:    "resource.requests.cpu",
+		},
+		"create deployment with gpu request below limit": {
+			resource: k8s_client.Resource{GPU: "2", Requests: &k8s_client.ResourceQuantities{GPU: "1"}},
+			field:    "resource.requests.gpu",
+		},
+		"create deployment with hugepages request without limit": {
+			resource: k8s_client.Resource{Requests: &k8s_client.ResourceQuantities{HugePages: map[string]string{"1Gi": "2Gi"}}},
+			field:    "resource.requests.hugePages.1Gi",
+		},
+		"create deployment with invalid page size": {
+			resource: k8s_client.Resource{Limits: &k8s_client.ResourceQuantities{HugePages: map[string]string{"huge": "2Gi"}}},
+			field:    "resource.limits.hugePages.huge",
+		},
+		"create deployment with shorthand and explicit limit": {
+			resource: k8s_client.Resource{GPU: "1", Limits: &k8s_client.ResourceQuantities{GPU: "2"}},
+			field:    "resource.limits.gpu",
+		},
+	}
+
+	for desc, tc := range cases {
+		d := k8s_client.Deployment{Name: name, Image: image, Resource: &tc.resource}
+
+		err := d.Validate()
+		if tc.field != "" {
+			fieldErr, ok := err.(*k8s_client.FieldError)
+			require.True(t, ok, fmt.Sprintf("%s: expected field error got %s", desc, err))
+			assert.Equal(t, tc.field, fieldErr.Field, fmt.Sprintf("%s: wrong field", desc))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		h := mocks.NewHarness(namespace)
+		_, err = h.Service.CreateDeployment(context.Background(), d, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		created, err := h.ClientSet.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		res := created.Spec.Template.Spec.Containers[0].Resources
+		assert.Equal(t, len(tc.limits), len(res.Limits), fmt.Sprintf("%s: wrong number of limits", desc))
+		for key, q := range tc.limits {
+			assert.Zero(t, q.Cmp(res.Limits[key]), fmt.Sprintf("%s: wrong %s limit", desc, key))
+		}
+		assert.Equal(t, len(tc.requests), len(res.Requests), fmt.Sprintf("%s: wrong number of requests", desc))
+		for key, q := range tc.requests {
+			assert.Zero(t, q.Cmp(res.Requests[key]), fmt.Sprintf("%s: wrong %s request", desc, key))
+		}
+	}
+}
+
+func TestCreateDeploymentEnv(t *testing.T) {
+	secretRef := &k8s_client.KeySelector{Name: "creds", Key: "token"}
+
+	cases := map[string]struct {
+		env     []*k8s_client.EnvVar
+		envFrom []*k8s_client.EnvFromSource
+		volumes []*k8s_client.VolumeInfo
+		err     error
+	}{
+		"create deployment with literal and secret env": {
+			env: []*k8s_client.EnvVar{
+				{Name: "MODE", Value: "train"},
+				{Name: "TOKEN", SecretKeyRef: secretRef},
+			},
+		},
+		"create deployment with env from configmap": {
+			envFrom: []*k8s_client.EnvFromSource{{Prefix: "CFG_", ConfigMapName: "settings"}},
+		},
+		"create deployment with secret and configmap volumes": {
+			volumes: []*k8s_client.VolumeInfo{
+				{Name: "creds", SecretName: "creds", MountPath: "/etc/creds", ReadOnly: true},
+				{Name: "settings", ConfigMapName: "settings", MountPath: "/etc/settings"},
+			},
+		},
+		"create deployment with env without name": {
+			env: []*k8s_client.EnvVar{{Value: "train"}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with env value and ref": {
+			env: []*k8s_client.EnvVar{{Name: "TOKEN", Value: "x", SecretKeyRef: secretRef}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with env from both sources": {
+			envFrom: []*k8s_client.EnvFromSource{{SecretName: "creds", ConfigMapName: "settings"}},
+			err:     k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with volume of two sources": {
+			volumes: []*k8s_client.VolumeInfo{{Name: "creds", SecretName: "creds", PVCName: "data", MountPath: "/etc/creds"}},
+			err:     k8s_client.ErrMalformedEntity,
+		},
+	}
+
+	for desc, tc := range cases {
+		d := k8s_client.Deployment{
+			Name:    name,
+			Image:   image,
+			Env:     tc.env,
+			EnvFrom: tc.envFrom,
+			Volumes: tc.volumes,
+		}
+		err := d.Validate()
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", desc, tc.err, err))
+		if tc.err != nil {
+			continue
+		}
+
+		h := mocks.NewHarness(namespace)
+		_, err = h.Service.CreateDeployment(context.Background(), d, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		created, err := h.ClientSet.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		spec := created.Spec.Template.Spec
+		container := spec.Containers[0]
+
+		require.Len(t, container.Env, len(tc.env), fmt.Sprintf("%s: wrong number of env vars", desc))
+		for i, e := range tc.env {
+			assert.Equal(t, e.Name, container.Env[i].Name, fmt.Sprintf("%s: wrong env name", desc))
+			assert.Equal(t, e.Value, container.Env[i].Value, fmt.Sprintf("%s: wrong env value", desc))
+			if e.SecretKeyRef != nil {
+				ref := container.Env[i].ValueFrom.SecretKeyRef
+				assert.Equal(t, e.SecretKeyRef.Name, ref.Name, fmt.Sprintf("%s: wrong secret name", desc))
+				assert.Equal(t, e.SecretKeyRef.Key, ref.Key, fmt.Sprintf("%s: wrong secret key", desc))
+			}
+		}
+
+		require.Len(t, container.EnvFrom, len(tc.envFrom), fmt.Sprintf("%s: wrong number of env sources", desc))
+		for i, s := range tc.envFrom {
+			assert.Equal(t, s.Prefix, container.EnvFrom[i].Prefix, fmt.Sprintf("%s: wrong env prefix", desc))
+			assert.Equal(t, s.ConfigMapName, container.EnvFrom[i].ConfigMapRef.Name, fmt.Sprintf("%s: wrong configmap name", desc))
+		}
+
+		require.Len(t, spec.Volumes, len(tc.volumes), fmt.Sprintf("%s: wrong number of volumes", desc))
+		for i, v := range tc.volumes {
+			if v.SecretName != "" {
+				assert.Equal(t, v.SecretName, spec.Volumes[i].Secret.SecretName, fmt.Sprintf("%s: wrong secret volume", desc))
+			}
+			if v.ConfigMapName != "" {
+				assert.Equal(t, v.ConfigMapName, spec.Volumes[i].ConfigMap.Name, fmt.Sprintf("%s: wrong configmap volume", desc))
+			}
+			assert.Equal(t, v.ReadOnly, container.VolumeMounts[i].ReadOnly, fmt.Sprintf("%s: wrong read only flag", desc))
+		}
+	}
+}
+
+func TestCreateRegistryCredential(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+
+	cred, err := h.Service.CreateRegistryCredential(context.Background(), k8s_client.RegistryCredential{
+		Name:     "private",
+		Server:   "https://registry.example.com/v2/",
+		Username: "ci",
+		Token:    "s3cr3t",
+	}, k8s_client.CreateOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	secret, err := h.ClientSet.CoreV1().Secrets(namespace).Get(cred.Name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, apiv1.SecretTypeDockerConfigJson, secret.Type, "wrong secret type")
+	assert.JSONEq(t,
+		`{"auths":{"registry.example.com":{"username":"ci","password":"s3cr3t","auth":"Y2k6czNjcjN0"}}}`,
+		string(secret.Data[apiv1.DockerConfigJsonKey]),
+		"wrong docker config",
+	)
+
+	creds, err := h.Service.ListRegistryCredentials(context.Background(), namespace)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, []k8s_client.RegistryCredentialStatus{{
+		Name:      "private",
+		Namespace: namespace,
+		Servers:   []string{"registry.example.com"},
+		Username:  "ci",
+	}}, creds, "wrong registry credentials")
+}
+
+func TestDeleteRegistryCredential(t *testing.T) {
+	h := mocks.NewHarness(namespace, registrySecret("private", "registry.example.com"), &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: namespace},
+		Type:       apiv1.SecretTypeOpaque,
+	})
+
+	cases := map[string]struct {
+		name string
+		err  error
+	}{
+		"delete registry credential":     {"private", nil},
+		"delete non-existent credential": {"missing", k8s_client.ErrNotFound},
+		"delete opaque secret":           {"opaque", k8s_client.ErrNotFound},
+	}
+
+	for desc, tc := range cases {
+		err := h.Service.DeleteRegistryCredential(context.Background(), namespace, tc.name, k8s_client.DeleteOptions{})
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", desc, tc.err, err))
+	}
+
+	_, err := h.ClientSet.CoreV1().Secrets(namespace).Get("opaque", metav1.GetOptions{})
+	assert.Nil(t, err, "opaque secret deleted")
+}
+
+func TestConfigMap(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+
+	_, err := h.Service.CreateConfigMap(context.Background(), k8s_client.ConfigMap{Name: name, Data: map[string]string{"batch-size": "32"}}, k8s_client.CreateOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	_, err = h.Service.UpdateConfigMap(context.Background(), k8s_client.ConfigMap{Name: name, Data: map[string]string{"epochs": "10"}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	cm, err := h.Service.GetConfigMap(context.Background(), "", name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, namespace, cm.Namespace, "wrong namespace")
+	assert.Equal(t, map[string]string{"epochs": "10"}, cm.Data, "data not replaced by update")
+
+	_, err = h.Service.UpdateConfigMap(context.Background(), k8s_client.ConfigMap{Name: "unknown"})
+	assert.Equal(t, k8s_client.ErrNotFound, err, fmt.Sprintf("update non-existing config map: expected %v got %v", k8s_client.ErrNotFound, err))
+
+	err = h.Service.DeleteConfigMap(context.Background(), "", name, k8s_client.DeleteOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	_, err = h.Service.GetConfigMap(context.Background(), "", name)
+	assert.Equal(t, k8s_client.ErrNotFound, err, fmt.Sprintf("get deleted config map: expected %v got %v", k8s_client.ErrNotFound, err))
+
+	err = k8s_client.ConfigMap{Name: name, Data: map[string]string{"batch size": "32"}}.Validate()
+	assert.IsType(t, &k8s_client.FieldError{}, err, fmt.Sprintf("invalid key: unexpected error %v", err))
+}
+
+func TestSecret(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+
+	_, err := h.Service.CreateSecret(context.Background(), k8s_client.Secret{Name: name, Data: map[string]string{"password": "s3cret"}}, k8s_client.CreateOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	_, err = h.Service.UpdateSecret(context.Background(), k8s_client.Secret{Name: name, Data: map[string]string{"username": "ci", "password": "t0ken"}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	secret, err := h.Service.GetSecret(context.Background(), "", name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, k8s_client.SecretStatus{Name: name, Namespace: namespace, Type: "Opaque", Keys: []string{"password", "username"}}, secret, "wrong secret status")
+
+	s, err := h.ClientSet.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, []byte("t0ken"), s.Data["password"], "secret value not updated")
+
+	_, err = h.Service.UpdateSecret(context.Background(), k8s_client.Secret{Name: name, Type: "kubernetes.io/tls"})
+	assert.IsType(t, &k8s_client.FieldError{}, err, fmt.Sprintf("change secret type: unexpected error %v", err))
+
+	err = h.Service.DeleteSecret(context.Background(), "", name, k8s_client.DeleteOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	err = h.Service.DeleteSecret(context.Background(), "", name, k8s_client.DeleteOptions{})
+	assert.Equal(t, k8s_client.ErrNotFound, err, fmt.Sprintf("delete non-existing secret: expected %v got %v", k8s_client.ErrNotFound, err))
+}
+
+func TestCreateDeploymentImagePullSecrets(t *testing.T) {
+	cases := map[string]struct {
+		image   string
+		names   []string
+		secrets []apiv1.LocalObjectReference
+	}{
+		"create deployment from matching registry": {
+			image:   "registry.example.com/team/train:1.0",
+			secrets: []apiv1.LocalObjectReference{{Name: "private"}},
+		},
+		"create deployment from registry with port": {
+			image:   "localhost:5000/train",
+			secrets: []apiv1.LocalObjectReference{{Name: "local"}},
+		},
+		"create deployment from docker hub": {
+			image:   "tensorflow/tensorflow",
+			secrets: []apiv1.LocalObjectReference{{Name: "hub"}},
+		},
+		"create deployment from unknown registry": {
+			image: "quay.io/team/train",
+		},
+		"create deployment with named secrets": {
+			image:   "registry.example.com/team/train",
+			names:   []string{"other"},
+			secrets: []apiv1.LocalObjectReference{{Name: "other"}},
+		},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace,
+			registrySecret("private", "registry.example.com"),
+			registrySecret("local", "localhost:5000"),
+			registrySecret("hub", "https://index.docker.io/v1/"),
+		)
+
+		_, err := h.Service.CreateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: tc.image, ImagePullSecrets: tc.names}, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		d, err := h.ClientSet.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, tc.secrets, d.Spec.Template.Spec.ImagePullSecrets, fmt.Sprintf("%s: wrong image pull secrets", desc))
+	}
+}
+
+func TestGetDeployment(t *testing.T) {
+	h := mocks.NewHarness(namespace, deployment(name, 2))
+
+	cases := map[string]struct {
+		name string
+		err  error
+	}{
+		"get existing deployment":     {name, nil},
+		"get non-existing deployment": {"unknown", k8s_client.ErrNotFound},
+	}
+
+	for desc, tc := range cases {
+		d, err := h.Service.GetDeployment(context.Background(), "", tc.name)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, int32(2), d.Replicas, fmt.Sprintf("%s: wrong replicas", desc))
+			assert.Equal(t, image, d.Image, fmt.Sprintf("%s: wrong image", desc))
+		}
+	}
+}
+
+func TestUpdateDeployment(t *testing.T) {
+	h := mocks.NewHarness(namespace, deployment(name, 1))
+
+	_, err := h.Service.UpdateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: "tensorflow/tensorflow:2.1.0-gpu", Volumes: volumes})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	d, err := h.ClientSet.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, int32(1), *d.Spec.Replicas, "replicas changed by patch without replicas")
+	assert.Equal(t, "tensorflow/tensorflow:2.1.0-gpu", d.Spec.Template.Spec.Containers[0].Image, "image not updated")
+	assertPodSpec(t, "update deployment", nil, volumes, d.Spec.Template.Spec)
+
+	_, err = h.Service.UpdateDeployment(context.Background(), k8s_client.Deployment{Name: "unknown", Image: image})
+	assert.Equal(t, k8s_client.ErrNotFound, err, fmt.Sprintf("update non-existing deployment: expected %v got %v", k8s_client.ErrNotFound, err))
+}
+
+func TestDeploymentValidatePatch(t *testing.T) {
+	cases := map[string]struct {
+		deployment k8s_client.Deployment
+		err        error
+	}{
+		"patch image":                  {k8s_client.Deployment{Name: name, Image: image}, nil},
+		"patch without image":          {k8s_client.Deployment{Name: name, Replicas: 2}, nil},
+		"patch without name":           {k8s_client.Deployment{Image: image}, k8s_client.ErrMalformedEntity},
+		"patch with nil volume":        {k8s_client.Deployment{Name: name, Volumes: []*k8s_client.VolumeInfo{nil}}, k8s_client.ErrMalformedEntity},
+		"patch with nil env":           {k8s_client.Deployment{Name: name, Env: []*k8s_client.EnvVar{nil}}, k8s_client.ErrMalformedEntity},
+		"patch with nil env from":      {k8s_client.Deployment{Name: name, EnvFrom: []*k8s_client.EnvFromSource{nil}}, k8s_client.ErrMalformedEntity},
+		"patch with nil port":          {k8s_client.Deployment{Name: name, Ports: []*k8s_client.ContainerPort{nil}}, k8s_client.ErrMalformedEntity},
+		"patch with nil toleration":    {k8s_client.Deployment{Name: name, Tolerations: []*k8s_client.Toleration{nil}}, k8s_client.ErrMalformedEntity},
+		"patch with empty pull secret": {k8s_client.Deployment{Name: name, ImagePullSecrets: []string{""}}, k8s_client.ErrMalformedEntity},
+	}
+
+	for desc, tc := range cases {
+		err := tc.deployment.ValidatePatch()
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+	}
+}
+
+func TestDeploymentPatchSkipsNilEntries(t *testing.T) {
+	d := deployment(name, 1)
+	patch := k8s_client.Deployment{
+		Name:    name,
+		Volumes: []*k8s_client.VolumeInfo{nil, volumes[0]},
+		Env:     []*k8s_client.EnvVar{nil, {Name: "EPOCHS", Value: "10"}},
+	}
+
+	require.Nil(t, patch.Patch(d), "unexpected error patching deployment")
+	spec := d.Spec.Template.Spec
+	assert.Len(t, spec.Volumes, 1, "nil volume not skipped")
+	assert.Len(t, spec.Containers[0].VolumeMounts, 1, "nil volume mount not skipped")
+	assert.Equal(t, []apiv1.EnvVar{{Name: "EPOCHS", Value: "10"}}, spec.Containers[0].Env, "nil env var not skipped")
+}
+
+func TestDeleteDeployment(t *testing.T) {
+	h := mocks.NewHarness(namespace, deployment(name, 1))
+
+	cases := map[string]struct {
+		name string
+		err  error
+	}{
+		"delete existing deployment":     {name, nil},
+		"delete non-existing deployment": {"unknown", k8s_client.ErrNotFound},
+	}
+
+	for desc, tc := range cases {
+		err := h.Service.DeleteDeployment(context.Background(), "", tc.name, k8s_client.DeleteOptions{})
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+	}
+
+	list, err := h.ClientSet.AppsV1().Deployments(namespace).List(metav1.ListOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Empty(t, list.Items, "deployment not deleted")
+}
+
+func TestCreateDeploymentApply(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	apply := k8s_client.CreateOptions{Apply: true}
+
+	cases := []struct {
+		desc       string
+		deployment k8s_client.Deployment
+		opts       k8s_client.CreateOptions
+		updates    int
+		err        error
+	}{
+		{"create new deployment", k8s_client.Deployment{Name: name, Image: image, Replicas: 1}, apply, 0, nil},
+		{"create existing deployment", k8s_client.Deployment{Name: name, Image: image, Replicas: 1}, k8s_client.CreateOptions{}, 0, k8s_client.ErrConflict},
+		{"apply same spec", k8s_client.Deployment{Name: name, Image: image, Replicas: 1}, apply, 0, nil},
+		{"apply changed spec", k8s_client.Deployment{Name: name, Image: "tensorflow/tensorflow:2.1.0-gpu", Replicas: 3}, apply, 1, nil},
+	}
+
+	for _, tc := range cases {
+		h.ClientSet.ClearActions()
+		_, err := h.Service.CreateDeployment(context.Background(), tc.deployment, tc.opts)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+
+		updates := 0
+		for _, action := range h.ClientSet.Actions() {
+			if action.GetVerb() == "update" {
+				updates++
+			}
+		}
+		assert.Equal(t, tc.updates, updates, fmt.Sprintf("%s: wrong number of updates", tc.desc))
+	}
+
+	d, err := h.ClientSet.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, int32(3), *d.Spec.Replicas, "replicas not converged")
+	assert.Equal(t, "tensorflow/tensorflow:2.1.0-gpu", d.Spec.Template.Spec.Containers[0].Image, "image not converged")
+}
+
+func TestCreateJobApply(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	apply := k8s_client.CreateOptions{Apply: true}
+	parallelism := int32(2)
+
+	cases := []struct {
+		desc string
+		job  k8s_client.Job
+		err  error
+	}{
+		{"create new job", k8s_client.Job{Name: name, Image: image, Volumes: volumes}, nil},
+		{"apply same spec", k8s_client.Job{Name: name, Image: image, Volumes: volumes}, nil},
+		{"apply changed parallelism", k8s_client.Job{Name: name, Image: image, Volumes: volumes, Parallelism: &parallelism}, nil},
+		{"apply changed image", k8s_client.Job{Name: name, Image: "tensorflow/tensorflow:2.1.0-gpu", Volumes: volumes}, &k8s_client.FieldError{Field: "image", Reason: "can't be changed"}},
+		{"apply changed command", k8s_client.Job{Name: name, Image: image, Volumes: volumes, Command: []string{"python"}}, &k8s_client.FieldError{Field: "command", Reason: "can't be changed"}},
+		{"apply changed volumes", k8s_client.Job{Name: name, Image: image}, &k8s_client.FieldError{Field: "volumes", Reason: "can't be changed"}},
+	}
+
+	for _, tc := range cases {
+		_, err := h.Service.CreateJob(context.Background(), tc.job, apply)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+	}
+
+	j, err := h.ClientSet.BatchV1().Jobs(namespace).Get(name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, parallelism, *j.Spec.Parallelism, "parallelism not converged")
+	assert.Equal(t, image, j.Spec.Template.Spec.Containers[0].Image, "image of existing job changed")
+}
+
+func TestCreateVolumeApply(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	apply := k8s_client.CreateOptions{Apply: true}
+	nfs := k8s_client.NFSPersistentVolume{Name: "nfs", Storage: "10Gi", Server: "10.0.0.1", Path: "/exports"}
+	pvc := k8s_client.PersistentVolumeClaim{Name: "dataset-pvc", Storage: "5Gi", StorageClassName: "datasets"}
+
+	grown := nfs
+	grown.Storage = "20Gi"
+	moved := nfs
+	moved.Path = "/datasets"
+	expanded := pvc
+	expanded.Storage = "8Gi"
+	shrunk := pvc
+	shrunk.Storage = "1Gi"
+	reclassed := pvc
+	reclassed.StorageClassName = "fast"
+
+	cases := []struct {
+		desc   string
+		create func() error
+		err    error
+	}{
+		{"create new pv", createNFSPV(h.Service, nfs, apply), nil},
+		{"apply pv capacity", createNFSPV(h.Service, grown, apply), nil},
+		{"apply pv source", createNFSPV(h.Service, moved, apply), &k8s_client.FieldError{Field: "source", Reason: "can't be changed"}},
+		{"create new pvc", createPVC(h.Service, pvc, apply), nil},
+		{"apply pvc storage expansion", createPVC(h.Service, expanded, apply), nil},
+		{"apply pvc storage shrink", createPVC(h.Service, shrunk, apply), &k8s_client.FieldError{Field: "storage", Reason: "can't be decreased"}},
+		{"apply pvc storage class", createPVC(h.Service, reclassed, apply), &k8s_client.FieldError{Field: "storageClassName", Reason: "can't be changed"}},
+	}
+
+	for _, tc := range cases {
+		err := tc.create()
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+	}
+
+	pv, err := h.ClientSet.CoreV1().PersistentVolumes().Get(nfs.Name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, resource.MustParse("20Gi"), pv.Spec.Capacity[apiv1.ResourceStorage], "pv capacity not converged")
+	assert.Equal(t, nfs.Path, pv.Spec.NFS.Path, "source of existing pv changed")
+
+	claim, err := h.ClientSet.CoreV1().PersistentVolumeClaims(namespace).Get(pvc.Name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, resource.MustParse("8Gi"), claim.Spec.Resources.Requests[apiv1.ResourceStorage], "pvc storage not expanded")
+}
+
+func createNFSPV(svc k8s_client.Service, pv k8s_client.NFSPersistentVolume, opts k8s_client.CreateOptions) func() error {
+	return func() error {
+		_, err := svc.CreateNFSPV(context.Background(), pv, opts)
+		return err
+	}
+}
+
+func createPVC(svc k8s_client.Service, pvc k8s_client.PersistentVolumeClaim, opts k8s_client.CreateOptions) func() error {
+	return func() error {
+		_, err := svc.CreatePVC(context.Background(), pvc, opts)
+		return err
+	}
+}
+
+func TestCreateSecretApply(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	apply := k8s_client.CreateOptions{Apply: true}
+
+	_, err := h.Service.CreateSecret(context.Background(), k8s_client.Secret{Name: name, Data: map[string]string{"password": "s3cret"}}, apply)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	_, err = h.Service.CreateSecret(context.Background(), k8s_client.Secret{Name: name, Data: map[string]string{"password": "t0ken"}}, apply)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	s, err := h.ClientSet.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, []byte("t0ken"), s.Data["password"], "secret value not converged")
+
+	_, err = h.Service.CreateSecret(context.Background(), k8s_client.Secret{Name: name, Type: "kubernetes.io/tls"}, apply)
+	assert.IsType(t, &k8s_client.FieldError{}, err, fmt.Sprintf("apply secret type: unexpected error %v", err))
+}
+
+func TestCreateDeploymentLocalDryRun(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+
+	cases := map[string]struct {
+		output   string
+		manifest string
+	}{
+		"render deployment as json": {k8s_client.OutputJSON, `"kind": "Deployment"`},
+		"render deployment as yaml": {k8s_client.OutputYAML, "kind: Deployment"},
+	}
+
+	for desc, tc := range cases {
+		res, err := h.Service.CreateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: image}, k8s_client.CreateOptions{DryRun: k8s_client.DryRunLocal, Output: tc.output})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, name, res.Name, fmt.Sprintf("%s: wrong name", desc))
+		manifest := res.Manifest
+		assert.Contains(t, manifest, tc.manifest, fmt.Sprintf("%s: wrong manifest", desc))
+		assert.Contains(t, manifest, namespace, fmt.Sprintf("%s: namespace not rendered", desc))
+	}
+
+	list, err := h.ClientSet.AppsV1().Deployments(namespace).List(metav1.ListOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Empty(t, list.Items, "deployment created by dry run")
+
+	err = k8s_client.CreateOptions{Apply: true, DryRun: k8s_client.DryRunLocal}.Validate()
+	assert.Equal(t, k8s_client.ErrMalformedEntity, err, fmt.Sprintf("apply with dry run: expected %v got %v", k8s_client.ErrMalformedEntity, err))
+}
+
+func TestCreateDeploymentServerDryRun(t *testing.T) {
+	var dryRun string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dryRun = r.URL.Query().Get("dryRun")
+
+		var d appsv1.Deployment
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &d)
+		d.Namespace = strings.Split(r.URL.Path, "/")[5]
+		d.UID = "defaulted-by-server"
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(d)
+	}))
+	defer server.Close()
+
+	clientSet, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	svc := k8s_client.New(clientSet, metricsfake.NewSimpleClientset(), namespace)
+
+	res, err := svc.CreateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: image}, k8s_client.CreateOptions{DryRun: k8s_client.DryRunServer, Output: k8s_client.OutputYAML})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, name, res.Name, "wrong name")
+	manifest := res.Manifest
+	assert.Equal(t, metav1.DryRunAll, dryRun, "dry run not requested from the API server")
+	assert.Contains(t, manifest, "kind: Deployment", "kind not rendered")
+	assert.Contains(t, manifest, "namespace: "+namespace, "namespace not rendered")
+	assert.Contains(t, manifest, "uid: defaulted-by-server", "server response not rendered")
+}
+
+func TestCreateSecretDryRunRedactsData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	clientSet, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	svc := k8s_client.New(clientSet, metricsfake.NewSimpleClientset(), namespace)
+
+	secret := k8s_client.Secret{Name: name, Data: map[string]string{"token": "hunter2"}}
+	cred := k8s_client.RegistryCredential{Name: "private", Server: "registry.example.com", Username: "ci", Token: "s3cr3t"}
+
+	cases := map[string]struct {
+		create func(k8s_client.CreateOptions) (k8s_client.CreateResult, error)
+		values []string
+	}{
+		"dry run secret": {
+			create: func(opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+				return svc.CreateSecret(context.Background(), secret, opts)
+			},
+			values: []string{"hunter2", "aHVudGVyMg=="},
+		},
+		"dry run registry credential": {
+			create: func(opts k8s_client.CreateOptions) (k8s_client.CreateResult, error) {
+				return svc.CreateRegistryCredential(context.Background(), cred, opts)
+			},
+			values: []string{"s3cr3t", "Y2k6czNjcjN0", apiv1.DockerConfigJsonKey},
+		},
+	}
+
+	for desc, tc := range cases {
+		for _, dryRun := range []string{k8s_client.DryRunLocal, k8s_client.DryRunServer} {
+			for _, output := range []string{k8s_client.OutputJSON, k8s_client.OutputYAML} {
+				res, err := tc.create(k8s_client.CreateOptions{DryRun: dryRun, Output: output})
+				require.Nil(t, err, fmt.Sprintf("%s %s %s: unexpected error %s", desc, dryRun, output, err))
+				manifest := res.Manifest
+				assert.Contains(t, manifest, "Secret", fmt.Sprintf("%s %s %s: kind not rendered", desc, dryRun, output))
+				for _, value := range tc.values {
+					assert.NotContains(t, manifest, value, fmt.Sprintf("%s %s %s: secret data rendered", desc, dryRun, output))
+				}
+			}
+		}
+	}
+}
+
+func TestStreamLogs(t *testing.T) {
+	long := strings.Repeat("x", 1<<20)
+
+	cases := map[string]struct {
+		log   io.Reader
+		lines []k8s_client.LogLine
+	}{
+		"stream lines": {
+			log: strings.NewReader("2020-04-01T10:00:00Z epoch 1\n2020-04-01T10:00:01Z epoch 2\n"),
+			lines: []k8s_client.LogLine{
+				{Pod: "mnist-0", Container: name, Timestamp: "2020-04-01T10:00:00Z", Message: "epoch 1"},
+				{Pod: "mnist-0", Container: name, Timestamp: "2020-04-01T10:00:01Z", Message: "epoch 2"},
+			},
+		},
+		"stream unterminated last line": {
+			log:   strings.NewReader("2020-04-01T10:00:00Z done"),
+			lines: []k8s_client.LogLine{{Pod: "mnist-0", Container: name, Timestamp: "2020-04-01T10:00:00Z", Message: "done"}},
+		},
+		"stream line longer than the scanner limit": {
+			log:   strings.NewReader("2020-04-01T10:00:00Z " + long + "\n"),
+			lines: []k8s_client.LogLine{{Pod: "mnist-0", Container: name, Timestamp: "2020-04-01T10:00:00Z", Message: long}},
+		},
+		"stream empty log": {
+			log: strings.NewReader(""),
+		},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace, workloadPod("mnist-0"))
+		h.Logs["mnist-0"] = tc.log
+
+		lines, err := h.Service.StreamLogs(context.Background(), namespace, name, k8s_client.LogOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		var got []k8s_client.LogLine
+		for l := range lines {
+			got = append(got, l)
+		}
+		assert.Equal(t, tc.lines, got, fmt.Sprintf("%s: wrong lines", desc))
+	}
+}
+
+func TestStreamLogsReportsReadError(t *testing.T) {
+	h := mocks.NewHarness(namespace, workloadPod("mnist-0"))
+	h.Logs["mnist-0"] = io.MultiReader(strings.NewReader("2020-04-01T10:00:00Z epoch 1\n"), brokenReader{})
+
+	lines, err := h.Service.StreamLogs(context.Background(), namespace, name, k8s_client.LogOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	var got []k8s_client.LogLine
+	for l := range lines {
+		got = append(got, l)
+	}
+
+	require.Len(t, got, 2, "expected a line and the read error")
+	assert.Equal(t, "epoch 1", got[0].Message, "wrong first line")
+	assert.Nil(t, got[0].Err, "unexpected error on first line")
+	assert.Equal(t, errors.Unavailable, errors.From(got[1].Err).Code, fmt.Sprintf("expected %s got %v", errors.Unavailable, got[1].Err))
+}
+
+func TestStreamLogsStopsOnCancel(t *testing.T) {
+	// The pipe is never closed by the writer, like a followed log of a
+	// running container.
+	log, writer := io.Pipe()
+	go writer.Write([]byte("2020-04-01T10:00:00Z epoch 1\n"))
+
+	h := mocks.NewHarness(namespace, workloadPod("mnist-0"))
+	h.Logs["mnist-0"] = log
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lines, err := h.Service.StreamLogs(ctx, namespace, name, k8s_client.LogOptions{Follow: true})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	line := <-lines
+	assert.Equal(t, "epoch 1", line.Message, "wrong line")
+
+	cancel()
+	for l := range lines {
+		assert.Nil(t, l.Err, fmt.Sprintf("unexpected error %s after cancel", l.Err))
+	}
+}
+
+func TestWatchDeployment(t *testing.T) {
+	d := deployment(name, 2)
+	d.ResourceVersion = "10"
+	p := workloadPod("mnist-0")
+	p.ResourceVersion = "11"
+
+	h := mocks.NewHarness(namespace, d)
+	listPods(h, "12", *p)
+
+	// The first deployment watch is closed by the server after one event,
+	// which must resume the watch from that event on.
+	deployments := []*watch.FakeWatcher{watch.NewFake(), watch.NewFake()}
+	var resumedFrom []string
+	h.ClientSet.PrependWatchReactor("deployments", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		resumedFrom = append(resumedFrom, action.(k8stesting.WatchAction).GetWatchRestrictions().ResourceVersion)
+		return true, deployments[len(resumedFrom)-1], nil
+	})
+	pods := watch.NewFake()
+	h.ClientSet.PrependWatchReactor("pods", k8stesting.DefaultWatchReactor(pods, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := h.Service.WatchDeployment(ctx, namespace, name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	ready := d.DeepCopy()
+	ready.ResourceVersion, ready.Status.ReadyReplicas = "13", 1
+	running := p.DeepCopy()
+	running.ResourceVersion, running.Status.Phase = "14", apiv1.PodRunning
+	available := d.DeepCopy()
+	available.ResourceVersion, available.Status.ReadyReplicas, available.Status.AvailableReplicas = "15", 2, 2
+
+	updates := []func(){
+		func() { deployments[0].Modify(ready) },
+		func() { pods.Modify(running) },
+		func() {
+			deployments[0].Stop()
+			deployments[1].Modify(available)
+		},
+	}
+
+	expected := []k8s_client.WorkloadEvent{
+		{Type: "ADDED", Kind: k8s_client.KindDeployment, Name: name, Namespace: namespace, Replicas: 2},
+		{Type: "ADDED", Kind: k8s_client.KindPod, Name: "mnist-0", Namespace: namespace},
+		{Type: "MODIFIED", Kind: k8s_client.KindDeployment, Name: name, Namespace: namespace, Replicas: 2, ReadyReplicas: 1},
+		{Type: "MODIFIED", Kind: k8s_client.KindPod, Name: "mnist-0", Namespace: namespace, Phase: "Running"},
+		{Type: "MODIFIED", Kind: k8s_client.KindDeployment, Name: name, Namespace: namespace, Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 2},
+	}
+
+	for i, e := range expected {
+		if i >= 2 {
+			go updates[i-2]()
+		}
+		assert.Equal(t, e, <-events, fmt.Sprintf("event %d: wrong event", i))
+	}
+
+	assert.Equal(t, []string{"10", "13"}, resumedFrom, "watch not resumed from the last event")
+}
+
+func TestWatchDeploymentReportsExpiredWatch(t *testing.T) {
+	d := deployment(name, 1)
+	d.ResourceVersion = "10"
+
+	h := mocks.NewHarness(namespace, d)
+	listPods(h, "10")
+
+	deployments := watch.NewFake()
+	h.ClientSet.PrependWatchReactor("deployments", k8stesting.DefaultWatchReactor(deployments, nil))
+
+	events, err := h.Service.WatchDeployment(context.Background(), namespace, name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	e := <-events
+	assert.Nil(t, e.Err, fmt.Sprintf("unexpected error %s on initial event", e.Err))
+
+	go deployments.Error(&metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusGone,
+		Reason:  metav1.StatusReasonExpired,
+		Message: "too old resource version: 10 (25)",
+	})
+
+	e, ok := <-events
+	require.True(t, ok, "watch closed without reporting the error")
+	assert.Equal(t, "ERROR", e.Type, "wrong event type")
+	assert.Equal(t, errors.Unavailable, errors.From(e.Err).Code, fmt.Sprintf("expected %s got %v", errors.Unavailable, e.Err))
+	assert.Contains(t, e.Err.Error(), "too old resource version", "status message not reported")
+
+	_, ok = <-events
+	assert.False(t, ok, "watch not closed after the error")
+}
+
+func TestWatchJobStopsOnCancel(t *testing.T) {
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: "10"}}
+
+	h := mocks.NewHarness(namespace, job)
+	listPods(h, "10")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := h.Service.WatchJob(ctx, namespace, name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	e := <-events
+	assert.Equal(t, k8s_client.KindJob, e.Kind, "wrong initial event kind")
+
+	cancel()
+	for range events {
+	}
+
+	_, err = h.Service.WatchJob(context.Background(), namespace, "unknown")
+	assert.Equal(t, k8s_client.ErrNotFound, err, fmt.Sprintf("expected %v got %v", k8s_client.ErrNotFound, err))
+}
+
+// listPods makes pod lists return pods at resourceVersion, which the fake
+// clientset leaves empty and watches need to start from.
+func listPods(h mocks.Harness, resourceVersion string, pods ...apiv1.Pod) {
+	h.ClientSet.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &apiv1.PodList{ListMeta: metav1.ListMeta{ResourceVersion: resourceVersion}, Items: pods}, nil
+	})
+}
+
+func TestGetNodeMetrics(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	h.Metrics.PrependReactor("list", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &metricsv1beta1.NodeMetricsList{Items: []metricsv1beta1.NodeMetrics{{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpu-node"},
+			Timestamp:  metav1.NewTime(time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)),
+			Usage:      apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("1500m"), apiv1.ResourceMemory: resource.MustParse("4Gi")},
+		}}}, nil
+	})
+
+	nodes, err := h.Service.GetNodeMetrics(context.Background())
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, []k8s_client.NodeMetrics{{Name: "gpu-node", CPU: "1500m", Memory: "4Gi", Timestamp: "2020-04-01T10:00:00Z"}}, nodes, "wrong node metrics")
+}
+
+func TestGetPodMetrics(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+
+	var selector string
+	h.Metrics.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		selector = action.(k8stesting.ListAction).GetListRestrictions().Labels.String()
+		return true, &metricsv1beta1.PodMetricsList{Items: []metricsv1beta1.PodMetrics{{
+			ObjectMeta: metav1.ObjectMeta{Name: "mnist-0", Namespace: namespace, Labels: map[string]string{"app": name}},
+			Timestamp:  metav1.NewTime(time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)),
+			Containers: []metricsv1beta1.ContainerMetrics{
+				{Name: name, Usage: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("750m"), apiv1.ResourceMemory: resource.MustParse("1Gi")}},
+				{Name: "sidecar", Usage: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("250m"), apiv1.ResourceMemory: resource.MustParse("512Mi")}},
+				{Name: "idle"},
+			},
+		}}}, nil
+	})
+
+	pods, err := h.Service.GetPodMetrics(context.Background(), namespace, name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, "app=mnist", selector, "wrong pod selector")
+
+	expected := []k8s_client.PodMetrics{{
+		Name:      "mnist-0",
+		Namespace: namespace,
+		CPU:       "1",
+		Memory:    "1536Mi",
+		Timestamp: "2020-04-01T10:00:00Z",
+		Containers: []k8s_client.ContainerMetrics{
+			{Name: name, CPU: "750m", Memory: "1Gi"},
+			{Name: "sidecar", CPU: "250m", Memory: "512Mi"},
+			{Name: "idle"},
+		},
+	}}
+	assert.Equal(t, expected, pods, "wrong pod metrics")
+}
+
+func TestExposeDeployment(t *testing.T) {
+	ports := []*k8s_client.ContainerPort{{Name: "http", ContainerPort: 8080}, {Name: "grpc", ContainerPort: 8081}}
+
+	cases := map[string]struct {
+		exposure k8s_client.Exposure
+		ports    []apiv1.ServicePort
+		err      error
+	}{
+		"expose deployment on container ports": {
+			exposure: k8s_client.Exposure{Name: name},
+			ports: []apiv1.ServicePort{
+				{Name: "http", Port: 8080, TargetPort: intstr.FromInt(8080), Protocol: apiv1.ProtocolTCP},
+				{Name: "grpc", Port: 8081, TargetPort: intstr.FromInt(8081), Protocol: apiv1.ProtocolTCP},
+			},
+		},
+		"expose deployment on node port": {
+			exposure: k8s_client.Exposure{
+				Name:  name,
+				Type:  "NodePort",
+				Ports: []*k8s_client.ServicePort{{Port: 80, TargetPort: 8080, NodePort: 30080}},
+			},
+			ports: []apiv1.ServicePort{{Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 30080, Protocol: apiv1.ProtocolTCP}},
+		},
+		"expose deployment with ingress": {
+			exposure: k8s_client.Exposure{
+				Name:    name,
+				Type:    "LoadBalancer",
+				Ports:   []*k8s_client.ServicePort{{Port: 80, TargetPort: 8080}},
+				Ingress: &k8s_client.IngressRule{Host: "mnist.example.com", TLSSecretName: "mnist-tls"},
+			},
+			ports: []apiv1.ServicePort{{Port: 80, TargetPort: intstr.FromInt(8080), Protocol: apiv1.ProtocolTCP}},
+		},
+		"expose deployment with ingress to unknown port": {
+			exposure: k8s_client.Exposure{Name: name, Ingress: &k8s_client.IngressRule{ServicePort: 9090}},
+			err:      k8s_client.ErrMalformedEntity,
+		},
+		"expose non-existing deployment": {
+			exposure: k8s_client.Exposure{Name: "unknown"},
+			err:      k8s_client.ErrNotFound,
+		},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace)
+		_, err := h.Service.CreateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: image, Ports: ports}, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		owner := setDeploymentUID(t, h, name)
+
+		svcName, err := h.Service.ExposeDeployment(context.Background(), tc.exposure)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+		if tc.err != nil {
+			continue
+		}
+
+		svc, err := h.ClientSet.CoreV1().Services(namespace).Get(svcName, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, map[string]string{"app": name}, svc.Spec.Selector, fmt.Sprintf("%s: wrong selector", desc))
+		assert.Equal(t, apiv1.ServiceType(tc.exposure.Type), svc.Spec.Type, fmt.Sprintf("%s: wrong service type", desc))
+		assert.Equal(t, tc.ports, svc.Spec.Ports, fmt.Sprintf("%s: wrong ports", desc))
+		assertOwnedBy(t, desc, owner, svc.OwnerReferences)
+
+		ing, err := h.ClientSet.NetworkingV1beta1().Ingresses(namespace).Get(name, metav1.GetOptions{})
+		if tc.exposure.Ingress == nil {
+			assert.NotNil(t, err, fmt.Sprintf("%s: unexpected ingress", desc))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		rule := ing.Spec.Rules[0]
+		assert.Equal(t, tc.exposure.Ingress.Host, rule.Host, fmt.Sprintf("%s: wrong host", desc))
+		assert.Equal(t, "/", rule.HTTP.Paths[0].Path, fmt.Sprintf("%s: wrong path", desc))
+		assert.Equal(t, intstr.FromInt(80), rule.HTTP.Paths[0].Backend.ServicePort, fmt.Sprintf("%s: wrong backend port", desc))
+		assert.Equal(t, tc.exposure.Ingress.TLSSecretName, ing.Spec.TLS[0].SecretName, fmt.Sprintf("%s: wrong tls secret", desc))
+		assertOwnedBy(t, desc, owner, ing.OwnerReferences)
+	}
+
+	invalid := map[string]k8s_client.Exposure{
+		"expose with unknown service type":         {Name: name, Type: "ExternalName"},
+		"expose cluster ip on node port":           {Name: name, Ports: []*k8s_client.ServicePort{{Port: 80, NodePort: 30080}}},
+		"expose unnamed ports":                     {Name: name, Ports: []*k8s_client.ServicePort{{Port: 80}, {Port: 443}}},
+		"expose with unknown protocol":             {Name: name, Ports: []*k8s_client.ServicePort{{Port: 80, Protocol: "HTTP"}}},
+		"expose with relative ingress path":        {Name: name, Ingress: &k8s_client.IngressRule{Host: "mnist.example.com", Path: "api"}},
+		"expose with tls but without ingress host": {Name: name, Ingress: &k8s_client.IngressRule{TLSSecretName: "mnist-tls"}},
+	}
+
+	for desc, exposure := range invalid {
+		err := exposure.Validate()
+		assert.Equal(t, k8s_client.ErrMalformedEntity, err, fmt.Sprintf("%s: expected %v got %v", desc, k8s_client.ErrMalformedEntity, err))
+	}
+}
+
+func TestExposeDeploymentRemovesServiceOnIngressFailure(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	_, err := h.Service.CreateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: image, Ports: []*k8s_client.ContainerPort{{ContainerPort: 8080}}}, k8s_client.CreateOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	h.ClientSet.PrependReactor("create", "ingresses", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8sErrors.NewServiceUnavailable("ingress controller unavailable")
+	})
+
+	_, err = h.Service.ExposeDeployment(context.Background(), k8s_client.Exposure{Name: name, Ingress: &k8s_client.IngressRule{Host: "mnist.example.com"}})
+	assert.Equal(t, errors.Unavailable, errors.From(err).Code, fmt.Sprintf("expected %s got %v", errors.Unavailable, err))
+
+	_, err = h.ClientSet.CoreV1().Services(namespace).Get(name, metav1.GetOptions{})
+	assert.True(t, k8sErrors.IsNotFound(err), fmt.Sprintf("service left behind after failed ingress: %v", err))
+}
+
+func TestExposeDeploymentTwice(t *testing.T) {
+	cases := map[string]*k8s_client.IngressRule{
+		"expose deployment again":              nil,
+		"expose deployment again with ingress": {Host: "mnist.example.com"},
+	}
+
+	for desc, ingress := range cases {
+		h := mocks.NewHarness(namespace)
+		_, err := h.Service.CreateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: image, Ports: []*k8s_client.ContainerPort{{ContainerPort: 8080}}}, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		exposure := k8s_client.Exposure{Name: name, Ingress: ingress}
+		_, err = h.Service.ExposeDeployment(context.Background(), exposure)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		_, err = h.Service.ExposeDeployment(context.Background(), exposure)
+		assert.Equal(t, k8s_client.ErrAlreadyExposed, err, fmt.Sprintf("%s: expected %v got %v", desc, k8s_client.ErrAlreadyExposed, err))
+	}
+
+	// A left over ingress must not leave the new service behind.
+	h := mocks.NewHarness(namespace)
+	_, err := h.Service.CreateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: image, Ports: []*k8s_client.ContainerPort{{ContainerPort: 8080}}}, k8s_client.CreateOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	_, err = h.ClientSet.NetworkingV1beta1().Ingresses(namespace).Create(&networkingv1beta1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	_, err = h.Service.ExposeDeployment(context.Background(), k8s_client.Exposure{Name: name, Ingress: &k8s_client.IngressRule{Host: "mnist.example.com"}})
+	assert.Equal(t, k8s_client.ErrAlreadyExposed, err, fmt.Sprintf("expected %v got %v", k8s_client.ErrAlreadyExposed, err))
+	_, err = h.ClientSet.CoreV1().Services(namespace).Get(name, metav1.GetOptions{})
+	assert.True(t, k8sErrors.IsNotFound(err), fmt.Sprintf("service left behind after existing ingress: %v", err))
+}
+
+// setDeploymentUID gives the named deployment the UID the API server would
+// have assigned, which the fake clientset leaves empty.
+func setDeploymentUID(t *testing.T, h mocks.Harness, name string) types.UID {
+	deployment, err := h.ClientSet.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	deployment.UID = types.UID(name + "-uid")
+	_, err = h.ClientSet.AppsV1().Deployments(namespace).Update(deployment)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	return deployment.UID
+}
+
+func assertOwnedBy(t *testing.T, desc string, uid types.UID, owners []metav1.OwnerReference) {
+	require.Len(t, owners, 1, fmt.Sprintf("%s: wrong owner references", desc))
+	assert.Equal(t, "Deployment", owners[0].Kind, fmt.Sprintf("%s: wrong owner kind", desc))
+	assert.Equal(t, uid, owners[0].UID, fmt.Sprintf("%s: wrong owner uid", desc))
+}
+
+func TestCanSchedule(t *testing.T) {
+	h := mocks.NewHarness(namespace, node("gpu-node", "4"), pod("running", "gpu-node", "3"))
+
+	cases := map[string]struct {
+		gpu         string
+		schedulable bool
+	}{
+		"schedule within free gpus":  {"1", true},
+		"schedule beyond free gpus":  {"2", false},
+		"schedule without resources": {"", true},
+	}
+
+	for desc, tc := range cases {
+		result, err := h.Service.CanSchedule(context.Background(), k8s_client.Deployment{Name: name, Image: image, Resource: &k8s_client.Resource{GPU: tc.gpu}})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, tc.schedulable, result.Schedulable, fmt.Sprintf("%s: %s", desc, result.Reason))
+	}
+}
+
+func TestCanScheduleReplicas(t *testing.T) {
+	cases := map[string]struct {
+		objects     []runtime.Object
+		replicas    int32
+		schedulable bool
+		nodes       []string
+	}{
+		"schedule replicas fitting on one node": {
+			objects:     []runtime.Object{node("gpu-node", "4")},
+			replicas:    4,
+			schedulable: true,
+			nodes:       []string{"gpu-node"},
+		},
+		"schedule more replicas than fit on one node": {
+			objects:  []runtime.Object{node("gpu-node", "4"), pod("running", "gpu-node", "3")},
+			replicas: 2,
+			nodes:    []string{"gpu-node"},
+		},
+		"schedule replicas spread over nodes": {
+			objects:     []runtime.Object{node("gpu-node", "4"), pod("running", "gpu-node", "3"), node("spare-node", "1")},
+			replicas:    2,
+			schedulable: true,
+			nodes:       []string{"gpu-node", "spare-node"},
+		},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace, tc.objects...)
+
+		d := k8s_client.Deployment{Name: name, Image: image, Replicas: tc.replicas, Resource: &k8s_client.Resource{GPU: "1"}}
+		result, err := h.Service.CanSchedule(context.Background(), d)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, tc.schedulable, result.Schedulable, fmt.Sprintf("%s: %s", desc, result.Reason))
+		assert.ElementsMatch(t, tc.nodes, result.Nodes, fmt.Sprintf("%s: wrong nodes", desc))
+	}
+}
+
+func TestCanScheduleInitContainers(t *testing.T) {
+	// The init container needs more than the app container, so the pod holds
+	// 3 of the 4 GPUs although its app container only asks for 1.
+	running := pod("running", "gpu-node", "1")
+	running.Spec.InitContainers = []apiv1.Container{{
+		Name:      "download",
+		Resources: apiv1.ResourceRequirements{Requests: apiv1.ResourceList{"nvidia.com/gpu": resource.MustParse("3")}},
+	}}
+	h := mocks.NewHarness(namespace, node("gpu-node", "4"), running)
+
+	capacity, err := h.Service.ClusterCapacity(context.Background())
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, "3", capacity[0].Requested.GPU, "init container request not accounted for")
+
+	cases := map[string]struct {
+		gpu         string
+		schedulable bool
+	}{
+		"schedule within gpus left by init container": {"1", true},
+		"schedule beyond gpus left by init container": {"2", false},
+	}
+
+	for desc, tc := range cases {
+		result, err := h.Service.CanSchedule(context.Background(), k8s_client.Deployment{Name: name, Image: image, Resource: &k8s_client.Resource{GPU: tc.gpu}})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, tc.schedulable, result.Schedulable, fmt.Sprintf("%s: %s", desc, result.Reason))
+	}
+}
+
+func TestCanScheduleConstraints(t *testing.T) {
+	a100 := node("a100-node", "8")
+	a100.Labels = map[string]string{"nvidia.com/gpu.product": "A100"}
+	a100.Spec.Taints = []apiv1.Taint{{Key: "pool", Value: "training", Effect: apiv1.TaintEffectNoSchedule}}
+	h := mocks.NewHarness(namespace, node("gpu-node", "1"), a100)
+
+	toleration := &k8s_client.Toleration{Key: "pool", Value: "training", Effect: "NoSchedule"}
+	a100Term := &k8s_client.NodeSelectorTerm{
+		MatchExpressions: []*k8s_client.LabelRequirement{{Key: "nvidia.com/gpu.product", Operator: "In", Values: []string{"A100"}}},
+	}
+
+	cases := map[string]struct {
+		deployment k8s_client.Deployment
+		nodes      []string
+	}{
+		"schedule without toleration": {
+			deployment: k8s_client.Deployment{},
+			nodes:      []string{"gpu-node"},
+		},
+		"schedule with toleration": {
+			deployment: k8s_client.Deployment{Tolerations: []*k8s_client.Toleration{toleration}},
+			nodes:      []string{"a100-node", "gpu-node"},
+		},
+		"schedule with toleration and node selector": {
+			deployment: k8s_client.Deployment{
+				Tolerations:  []*k8s_client.Toleration{toleration},
+				NodeSelector: map[string]string{"nvidia.com/gpu.product": "A100"},
+			},
+			nodes: []string{"a100-node"},
+		},
+		"schedule with node affinity but no toleration": {
+			deployment: k8s_client.Deployment{Affinity: &k8s_client.Affinity{NodeAffinity: []*k8s_client.NodeSelectorTerm{a100Term}}},
+			nodes:      []string{},
+		},
+	}
+
+	for desc, tc := range cases {
+		d := tc.deployment
+		d.Name, d.Image = name, image
+		d.Resource = &k8s_client.Resource{GPU: "1"}
+
+		result, err := h.Service.CanSchedule(context.Background(), d)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.ElementsMatch(t, tc.nodes, result.Nodes, fmt.Sprintf("%s: wrong nodes", desc))
+		assert.Equal(t, len(tc.nodes) > 0, result.Schedulable, fmt.Sprintf("%s: %s", desc, result.Reason))
+	}
+}
+
+func TestCreateDeploymentScheduling(t *testing.T) {
+	seconds := int64(60)
+
+	cases := map[string]struct {
+		deployment k8s_client.Deployment
+		err        error
+	}{
+		"create deployment with scheduling controls": {
+			deployment: k8s_client.Deployment{
+				NodeSelector: map[string]string{"pool": "inference"},
+				Tolerations: []*k8s_client.Toleration{
+					{Key: "pool", Operator: "Exists", Effect: "NoExecute", TolerationSeconds: &seconds},
+				},
+				Affinity: &k8s_client.Affinity{
+					NodeAffinity: []*k8s_client.NodeSelectorTerm{
+						{MatchExpressions: []*k8s_client.LabelRequirement{{Key: "gpu", Operator: "Exists"}}},
+						{Weight: 10, MatchExpressions: []*k8s_client.LabelRequirement{{Key: "gpu-count", Operator: "Gt", Values: []string{"2"}}}},
+					},
+					PodAntiAffinity: []*k8s_client.PodAffinityTerm{
+						{MatchLabels: map[string]string{"role": "training"}, TopologyKey: "kubernetes.io/hostname"},
+					},
+				},
+				PriorityClassName: "high",
+				SchedulerName:     "gpu-scheduler",
+			},
+		},
+		"create deployment with toleration of unknown effect": {
+			deployment: k8s_client.Deployment{Tolerations: []*k8s_client.Toleration{{Key: "pool", Effect: "Never"}}},
+			err:        k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with toleration value and exists operator": {
+			deployment: k8s_client.Deployment{Tolerations: []*k8s_client.Toleration{{Key: "pool", Operator: "Exists", Value: "x"}}},
+			err:        k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with toleration seconds without no execute": {
+			deployment: k8s_client.Deployment{Tolerations: []*k8s_client.Toleration{{Key: "pool", TolerationSeconds: &seconds}}},
+			err:        k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with node affinity without values": {
+			deployment: k8s_client.Deployment{Affinity: &k8s_client.Affinity{NodeAffinity: []*k8s_client.NodeSelectorTerm{
+				{MatchExpressions: []*k8s_client.LabelRequirement{{Key: "gpu", Operator: "In"}}},
+			}}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with pod affinity gt operator": {
+			deployment: k8s_client.Deployment{Affinity: &k8s_client.Affinity{PodAffinity: []*k8s_client.PodAffinityTerm{
+				{MatchExpressions: []*k8s_client.LabelRequirement{{Key: "gpu", Operator: "Gt", Values: []string{"1"}}}, TopologyKey: "zone"},
+			}}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with pod affinity without topology key": {
+			deployment: k8s_client.Deployment{Affinity: &k8s_client.Affinity{PodAffinity: []*k8s_client.PodAffinityTerm{
+				{MatchLabels: map[string]string{"role": "training"}},
+			}}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+		"create deployment with out of range weight": {
+			deployment: k8s_client.Deployment{Affinity: &k8s_client.Affinity{NodeAffinity: []*k8s_client.NodeSelectorTerm{
+				{Weight: 101, MatchExpressions: []*k8s_client.LabelRequirement{{Key: "gpu", Operator: "Exists"}}},
+			}}},
+			err: k8s_client.ErrMalformedEntity,
+		},
+	}
+
+	for desc, tc := range cases {
+		d := tc.deployment
+		d.Name, d.Image = name, image
+
+		err := d.Validate()
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", desc, tc.err, err))
+		if tc.err != nil {
+			continue
+		}
+
+		h := mocks.NewHarness(namespace)
+		_, err = h.Service.CreateDeployment(context.Background(), d, k8s_client.CreateOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+
+		created, err := h.ClientSet.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		spec := created.Spec.Template.Spec
+
+		assert.Equal(t, d.NodeSelector, spec.NodeSelector, fmt.Sprintf("%s: wrong node selector", desc))
+		assert.Equal(t, d.PriorityClassName, spec.PriorityClassName, fmt.Sprintf("%s: wrong priority class", desc))
+		assert.Equal(t, d.SchedulerName, spec.SchedulerName, fmt.Sprintf("%s: wrong scheduler", desc))
+		assert.Equal(t, []apiv1.Toleration{
+			{Key: "pool", Operator: apiv1.TolerationOpExists, Effect: apiv1.TaintEffectNoExecute, TolerationSeconds: &seconds},
+		}, spec.Tolerations, fmt.Sprintf("%s: wrong tolerations", desc))
+
+		require.NotNil(t, spec.Affinity, fmt.Sprintf("%s: missing affinity", desc))
+		nodeAffinity := spec.Affinity.NodeAffinity
+		require.NotNil(t, nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution, fmt.Sprintf("%s: missing required node affinity", desc))
+		assert.Len(t, nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms, 1, fmt.Sprintf("%s: wrong required node terms", desc))
+		require.Len(t, nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, 1, fmt.Sprintf("%s: wrong preferred node terms", desc))
+		assert.Equal(t, int32(10), nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].Weight, fmt.Sprintf("%s: wrong node term weight", desc))
+		assert.Nil(t, spec.Affinity.PodAffinity, fmt.Sprintf("%s: unexpected pod affinity", desc))
+		require.Len(t, spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1, fmt.Sprintf("%s: wrong pod anti-affinity terms", desc))
+		term := spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0]
+		assert.Equal(t, "kubernetes.io/hostname", term.TopologyKey, fmt.Sprintf("%s: wrong topology key", desc))
+		assert.Equal(t, map[string]string{"role": "training"}, term.LabelSelector.MatchLabels, fmt.Sprintf("%s: wrong label selector", desc))
+	}
+}
+
+func assertPodSpec(t *testing.T, desc string, res *k8s_client.Resource, volumes []*k8s_client.VolumeInfo, spec apiv1.PodSpec) {
+	require.Len(t, spec.Containers, 1, fmt.Sprintf("%s: wrong number of containers", desc))
+	container := spec.Containers[0]
+	assert.Equal(t, name, container.Name, fmt.Sprintf("%s: wrong container name", desc))
+
+	if res != nil {
+		for key, value := range map[apiv1.ResourceName]string{"cpu": res.CPU, "memory": res.Memory, "nvidia.com/gpu": res.GPU} {
+			if value == "" {
+				assert.NotContains(t, container.Resources.Limits, key, fmt.Sprintf("%s: unexpected %s limit", desc, key))
+				continue
+			}
+			assert.Equal(t, resource.MustParse(value), container.Resources.Limits[key], fmt.Sprintf("%s: wrong %s limit", desc, key))
+		}
+	}
+
+	require.Len(t, spec.Volumes, len(volumes), fmt.Sprintf("%s: wrong number of volumes", desc))
+	require.Len(t, container.VolumeMounts, len(volumes), fmt.Sprintf("%s: wrong number of volume mounts", desc))
+	for i, v := range volumes {
+		assert.Equal(t, v.Name, spec.Volumes[i].Name, fmt.Sprintf("%s: wrong volume name", desc))
+		assert.Equal(t, v.PVCName, spec.Volumes[i].PersistentVolumeClaim.ClaimName, fmt.Sprintf("%s: wrong claim name", desc))
+		assert.Equal(t, v.Name, container.VolumeMounts[i].Name, fmt.Sprintf("%s: wrong volume mount name", desc))
+		assert.Equal(t, v.MountPath, container.VolumeMounts[i].MountPath, fmt.Sprintf("%s: wrong mount path", desc))
+	}
+}
+
+func deployment(name string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: apiv1.PodTemplateSpec{
+				Spec: apiv1.PodSpec{
+					Containers: []apiv1.Container{{Name: name, Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func node(name, gpus string) *apiv1.Node {
+	return &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: apiv1.NodeStatus{
+			Allocatable: apiv1.ResourceList{"nvidia.com/gpu": resource.MustParse(gpus)},
+			Conditions:  []apiv1.NodeCondition{{Type: apiv1.NodeReady, Status: apiv1.ConditionTrue}},
+		},
+	}
+}
+
+func pod(name, nodeName, gpus string) *apiv1.Pod {
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: apiv1.PodSpec{
+			NodeName: nodeName,
+			Containers: []apiv1.Container{{
+				Name:      name,
+				Resources: apiv1.ResourceRequirements{Limits: apiv1.ResourceList{"nvidia.com/gpu": resource.MustParse(gpus)}},
+			}},
+		},
+	}
+}
+
+func workloadPod(name string) *apiv1.Pod {
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"app": "mnist"}},
+		Spec:       apiv1.PodSpec{Containers: []apiv1.Container{{Name: "mnist"}}},
+	}
+}
+
+// brokenReader fails like a log stream cut off by the API server.
+type brokenReader struct{}
+
+func (brokenReader) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("connection reset by peer")
+}
+
+func registrySecret(name, server string) *apiv1.Secret {
+	return &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       apiv1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			apiv1.DockerConfigJsonKey: []byte(fmt.Sprintf(`{"auths":{%q:{"username":"ci","password":"token"}}}`, server)),
+		},
+	}
+}
+
+func TestCreateDeploymentErrors(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+
+	cases := map[string]struct {
+		err    error
+		code   errors.Code
+		fields []errors.FieldViolation
+	}{
+		"create invalid deployment": {
+			err: k8sErrors.NewInvalid(schema.GroupKind{Group: "apps", Kind: "Deployment"}, name, field.ErrorList{
+				field.Required(field.NewPath("spec", "template", "spec", "containers").Index(0).Child("image"), ""),
+			}),
+			code:   errors.Invalid,
+			fields: []errors.FieldViolation{{Field: "spec.template.spec.containers[0].image", Reason: "Required value"}},
+		},
+		"create deployment over quota": {
+			err:  k8sErrors.NewForbidden(deployments, name, fmt.Errorf("exceeded quota: compute, requested: requests.nvidia.com/gpu=2")),
+			code: errors.QuotaExceeded,
+		},
+		"create forbidden deployment": {
+			err:  k8sErrors.NewForbidden(deployments, name, fmt.Errorf("service account can't create deployments")),
+			code: errors.Forbidden,
+		},
+		"create deployment on unavailable cluster": {
+			err:  k8sErrors.NewServiceUnavailable("etcd leader changed"),
+			code: errors.Unavailable,
+		},
+	}
+
+	for desc, tc := range cases {
+		h := mocks.NewHarness(namespace)
+		h.ClientSet.PrependReactor("create", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, tc.err
+		})
+
+		_, err := h.Service.CreateDeployment(context.Background(), k8s_client.Deployment{Name: name, Image: image}, k8s_client.CreateOptions{})
+		e := errors.From(err)
+		assert.Equal(t, tc.code, e.Code, fmt.Sprintf("%s: expected %s got %s", desc, tc.code, e.Code))
+		assert.Equal(t, tc.fields, e.Fields, fmt.Sprintf("%s: wrong fields", desc))
+	}
+}
+
+func TestCreateProject(t *testing.T) {
+	h := mocks.NewHarness(namespace)
+	project := k8s_client.Project{
+		Name:  "vision",
+		Quota: k8s_client.ProjectQuota{CPU: "16", Memory: "64Gi", GPU: "4", PVCs: "10", Storage: "1Ti"},
+		Limits: k8s_client.ProjectLimits{
+			Default: k8s_client.ResourceQuantities{CPU: "2"},
+		},
+	}
+
+	name, err := h.Service.CreateProject(context.Background(), project)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, project.Name, name, "wrong project name")
+
+	_, err = h.Service.CreateProject(context.Background(), project)
+	assert.Equal(t, k8s_client.ErrConflict, err, fmt.Sprintf("create existing project: expected %v got %v", k8s_client.ErrConflict, err))
+
+	quota, err := h.ClientSet.CoreV1().ResourceQuotas(project.Name).Get(project.Name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	for res, value := range map[apiv1.ResourceName]string{
+		apiv1.ResourceRequestsCPU:            "16",
+		apiv1.ResourceRequestsMemory:         "64Gi",
+		"requests.nvidia.com/gpu":            "4",
+		apiv1.ResourcePersistentVolumeClaims: "10",
+		apiv1.ResourceRequestsStorage:        "1Ti",
+	} {
+		q := quota.Spec.Hard[res]
+		assert.Equal(t, value, q.String(), fmt.Sprintf("wrong quota of %s", res))
+	}
+
+	limitRange, err := h.ClientSet.CoreV1().LimitRanges(project.Name).Get(project.Name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	require.Len(t, limitRange.Spec.Limits, 1, "wrong number of limits")
+	limits := limitRange.Spec.Limits[0]
+	_, ok := limits.DefaultRequest[apiv1.ResourceCPU]
+	assert.False(t, ok, "default cpu request set despite default cpu limit")
+	memory := limits.DefaultRequest[apiv1.ResourceMemory]
+	assert.Equal(t, "128Mi", memory.String(), "default memory request not set")
+
+	binding, err := h.ClientSet.RbacV1().RoleBindings(project.Name).Get(project.Name, metav1.GetOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, "edit", binding.RoleRef.Name, "wrong role bound")
+	require.Len(t, binding.Subjects, 1, "wrong number of subjects")
+	assert.Equal(t, project.Name, binding.Subjects[0].Name, "service account not bound")
+
+	status, err := h.Service.GetProject(context.Background(), project.Name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, project.Quota, status.Quota, "wrong project quota")
+	assert.Equal(t, project.Name, status.ServiceAccount, "wrong project service account")
+}
+
+func TestUpdateProject(t *testing.T) {
+	h := mocks.NewHarness(namespace, &apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}})
+	project := k8s_client.Project{Name: "vision", Quota: k8s_client.ProjectQuota{GPU: "4"}}
+
+	_, err := h.Service.CreateProject(context.Background(), project)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	err = h.ClientSet.CoreV1().ServiceAccounts(project.Name).Delete(project.Name, &metav1.DeleteOptions{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	project.Quota.GPU = "8"
+	_, err = h.Service.UpdateProject(context.Background(), project)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	status, err := h.Service.GetProject(context.Background(), project.Name)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, "8", status.Quota.GPU, "quota not reconciled")
+	assert.Equal(t, project.Name, status.ServiceAccount, "service account not recreated")
+
+	cases := map[string]struct {
+		name string
+		err  error
+	}{
+		"update non-project namespace": {"kube-system", k8s_client.ErrNotFound},
+		"update non-existing project":  {"unknown", k8s_client.ErrNotFound},
+	}
+
+	for desc, tc := range cases {
+		_, err := h.Service.UpdateProject(context.Background(), k8s_client.Project{Name: tc.name})
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+	}
+}
+
+func TestDeleteProject(t *testing.T) {
+	h := mocks.NewHarness(namespace, &apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}})
+
+	_, err := h.Service.CreateProject(context.Background(), k8s_client.Project{Name: "vision"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	cases := map[string]struct {
+		name string
+		err  error
+	}{
+		"delete existing project":      {"vision", nil},
+		"delete non-project namespace": {"kube-system", k8s_client.ErrNotFound},
+		"delete non-existing project":  {"unknown", k8s_client.ErrNotFound},
+	}
+
+	for desc, tc := range cases {
+		err := h.Service.DeleteProject(context.Background(), tc.name, k8s_client.DeleteOptions{})
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+	}
+
+	_, err = h.ClientSet.CoreV1().Namespaces().Get("vision", metav1.GetOptions{})
+	assert.True(t, k8sErrors.IsNotFound(err), "project namespace not deleted")
+	_, err = h.ClientSet.CoreV1().Namespaces().Get("kube-system", metav1.GetOptions{})
+	assert.Nil(t, err, "non-project namespace deleted")
+}
+
+func TestProjectValidate(t *testing.T) {
+	cases := map[string]struct {
+		project k8s_client.Project
+		field   string
+	}{
+		"invalid name":    {k8s_client.Project{Name: "Vision"}, "name"},
+		"invalid quota":   {k8s_client.Project{Name: "vision", Quota: k8s_client.ProjectQuota{Memory: "lots"}}, "quota.memory"},
+		"fractional pvcs": {k8s_client.Project{Name: "vision", Quota: k8s_client.ProjectQuota{PVCs: "1500m"}}, "quota.pvcs"},
+		"request > limit": {k8s_client.Project{Name: "vision", Limits: k8s_client.ProjectLimits{Default: k8s_client.ResourceQuantities{Memory: "64Mi"}, DefaultRequest: k8s_client.ResourceQuantities{Memory: "1Gi"}}}, "limits.defaultRequest.memory"},
+		"valid project":   {k8s_client.Project{Name: "vision", Quota: k8s_client.ProjectQuota{PVCs: "10"}}, ""},
+	}
+
+	for desc, tc := range cases {
+		err := tc.project.Validate()
+		if tc.field == "" {
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %v", desc, err))
+			continue
+		}
+
+		fieldErr, ok := err.(*k8s_client.FieldError)
+		require.True(t, ok, fmt.Sprintf("%s: expected field error got %v", desc, err))
+		assert.Equal(t, tc.field, fieldErr.Field, fmt.Sprintf("%s: wrong field", desc))
+	}
+}
@@ -0,0 +1,293 @@
+package k8s_client
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const hostnameLabel = "kubernetes.io/hostname"
+
+// PersistentVolume is a cluster-wide volume backed by exactly one of the
+// supported volume sources. Only claims asking for a subset of AccessModes,
+// the same StorageClassName and, when they have a selector, matching Labels
+// bind to it. Without AccessModes it is ReadWriteOnce.
+type PersistentVolume struct {
+	Name             string
+	Storage          string
+	AccessModes      []string
+	StorageClassName string
+	Labels           map[string]string
+	Source           VolumeSource
+}
+
+func (pv PersistentVolume) Validate() error {
+	if pv.Name == "" {
+		return ErrMalformedEntity
+	}
+
+	if _, err := resource.ParseQuantity(pv.Storage); err != nil {
+		return ErrMalformedEntity
+	}
+
+	if err := validateAccessModes(pv.AccessModes); err != nil {
+		return err
+	}
+
+	for key := range pv.Labels {
+		if key == "" {
+			return ErrMalformedEntity
+		}
+	}
+
+	return pv.Source.Validate()
+}
+
+// VolumeSource is a union of the supported PersistentVolume backends. Only
+// one of its fields may be set.
+type VolumeSource struct {
+	NFS      *NFSVolumeSource
+	HostPath *HostPathVolumeSource
+	CephFS   *CephFSVolumeSource
+	ISCSI    *ISCSIVolumeSource
+	Local    *LocalVolumeSource
+	CSI      *CSIVolumeSource
+}
+
+func (s VolumeSource) Validate() error {
+	var backend interface{ Validate() error }
+	set := 0
+
+	if s.NFS != nil {
+		backend, set = s.NFS, set+1
+	}
+	if s.HostPath != nil {
+		backend, set = s.HostPath, set+1
+	}
+	if s.CephFS != nil {
+		backend, set = s.CephFS, set+1
+	}
+	if s.ISCSI != nil {
+		backend, set = s.ISCSI, set+1
+	}
+	if s.Local != nil {
+		backend, set = s.Local, set+1
+	}
+	if s.CSI != nil {
+		backend, set = s.CSI, set+1
+	}
+
+	if set != 1 {
+		return ErrMalformedEntity
+	}
+
+	return backend.Validate()
+}
+
+type NFSVolumeSource struct {
+	Server   string
+	Path     string
+	ReadOnly bool
+}
+
+func (s NFSVolumeSource) Validate() error {
+	if s.Server == "" || s.Path == "" {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type HostPathVolumeSource struct {
+	Path string
+	Type string
+}
+
+func (s HostPathVolumeSource) Validate() error {
+	if s.Path == "" {
+		return ErrMalformedEntity
+	}
+
+	switch apiv1.HostPathType(s.Type) {
+	case apiv1.HostPathUnset, apiv1.HostPathDirectoryOrCreate, apiv1.HostPathDirectory,
+		apiv1.HostPathFileOrCreate, apiv1.HostPathFile, apiv1.HostPathSocket,
+		apiv1.HostPathCharDev, apiv1.HostPathBlockDev:
+		return nil
+	default:
+		return ErrMalformedEntity
+	}
+}
+
+type CephFSVolumeSource struct {
+	Monitors        []string
+	Path            string
+	User            string
+	SecretName      string
+	SecretNamespace string
+	ReadOnly        bool
+}
+
+func (s CephFSVolumeSource) Validate() error {
+	if len(s.Monitors) == 0 {
+		return ErrMalformedEntity
+	}
+
+	for _, m := range s.Monitors {
+		if m == "" {
+			return ErrMalformedEntity
+		}
+	}
+
+	if s.SecretNamespace != "" && s.SecretName == "" {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type ISCSIVolumeSource struct {
+	TargetPortal string
+	Portals      []string
+	IQN          string
+	Lun          int32
+	FSType       string
+	ReadOnly     bool
+}
+
+func (s ISCSIVolumeSource) Validate() error {
+	if s.TargetPortal == "" || s.IQN == "" {
+		return ErrMalformedEntity
+	}
+
+	if s.Lun < 0 || s.Lun > 255 {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// LocalVolumeSource is a disk or directory on specific nodes. Pods using it
+// are pinned to those nodes through the volume node affinity.
+type LocalVolumeSource struct {
+	Path   string
+	FSType string
+	Nodes  []string
+}
+
+func (s LocalVolumeSource) Validate() error {
+	if s.Path == "" || len(s.Nodes) == 0 {
+		return ErrMalformedEntity
+	}
+
+	for _, n := range s.Nodes {
+		if n == "" {
+			return ErrMalformedEntity
+		}
+	}
+
+	return nil
+}
+
+type CSIVolumeSource struct {
+	Driver       string
+	VolumeHandle string
+	FSType       string
+	ReadOnly     bool
+	Attributes   map[string]string
+}
+
+func (s CSIVolumeSource) Validate() error {
+	if s.Driver == "" || s.VolumeHandle == "" {
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+func (pv PersistentVolume) spec() (apiv1.PersistentVolumeSpec, error) {
+	storage, err := resource.ParseQuantity(pv.Storage)
+	if err != nil {
+		return apiv1.PersistentVolumeSpec{}, err
+	}
+
+	if err := validateAccessModes(pv.AccessModes); err != nil {
+		return apiv1.PersistentVolumeSpec{}, err
+	}
+
+	spec := apiv1.PersistentVolumeSpec{
+		Capacity: apiv1.ResourceList{
+			"storage": storage,
+		},
+		AccessModes:      persistentVolumeAccessModes(pv.AccessModes),
+		StorageClassName: pv.StorageClassName,
+	}
+
+	s := pv.Source
+	switch {
+	case s.NFS != nil:
+		spec.NFS = &apiv1.NFSVolumeSource{
+			Server:   s.NFS.Server,
+			Path:     s.NFS.Path,
+			ReadOnly: s.NFS.ReadOnly,
+		}
+	case s.HostPath != nil:
+		hostPathType := apiv1.HostPathType(s.HostPath.Type)
+		spec.HostPath = &apiv1.HostPathVolumeSource{
+			Path: s.HostPath.Path,
+			Type: &hostPathType,
+		}
+	case s.CephFS != nil:
+		spec.CephFS = &apiv1.CephFSPersistentVolumeSource{
+			Monitors: s.CephFS.Monitors,
+			Path:     s.CephFS.Path,
+			User:     s.CephFS.User,
+			ReadOnly: s.CephFS.ReadOnly,
+		}
+		if s.CephFS.SecretName != "" {
+			spec.CephFS.SecretRef = &apiv1.SecretReference{
+				Name:      s.CephFS.SecretName,
+				Namespace: s.CephFS.SecretNamespace,
+			}
+		}
+	case s.ISCSI != nil:
+		spec.ISCSI = &apiv1.ISCSIPersistentVolumeSource{
+			TargetPortal: s.ISCSI.TargetPortal,
+			Portals:      s.ISCSI.Portals,
+			IQN:          s.ISCSI.IQN,
+			Lun:          s.ISCSI.Lun,
+			FSType:       s.ISCSI.FSType,
+			ReadOnly:     s.ISCSI.ReadOnly,
+		}
+	case s.Local != nil:
+		var fsType *string
+		if s.Local.FSType != "" {
+			fsType = &s.Local.FSType
+		}
+		spec.Local = &apiv1.LocalVolumeSource{
+			Path:   s.Local.Path,
+			FSType: fsType,
+		}
+		spec.NodeAffinity = &apiv1.VolumeNodeAffinity{
+			Required: &apiv1.NodeSelector{
+				NodeSelectorTerms: []apiv1.NodeSelectorTerm{{
+					MatchExpressions: []apiv1.NodeSelectorRequirement{{
+						Key:      hostnameLabel,
+						Operator: apiv1.NodeSelectorOpIn,
+						Values:   s.Local.Nodes,
+					}},
+				}},
+			},
+		}
+	case s.CSI != nil:
+		spec.CSI = &apiv1.CSIPersistentVolumeSource{
+			Driver:           s.CSI.Driver,
+			VolumeHandle:     s.CSI.VolumeHandle,
+			FSType:           s.CSI.FSType,
+			ReadOnly:         s.CSI.ReadOnly,
+			VolumeAttributes: s.CSI.Attributes,
+		}
+	default:
+		return apiv1.PersistentVolumeSpec{}, ErrMalformedEntity
+	}
+
+	return spec, nil
+}
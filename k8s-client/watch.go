@@ -0,0 +1,268 @@
+package k8s_client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hykuan/k8s-client-example/errors"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	apiv1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+const (
+	// KindDeployment marks events describing the watched Deployment.
+	KindDeployment = "Deployment"
+
+	// KindJob marks events describing the watched Job.
+	KindJob = "Job"
+
+	// KindPod marks events describing one of the pods of the watched workload.
+	KindPod = "Pod"
+)
+
+// ContainerState describes the current state of a single pod container.
+type ContainerState struct {
+	Name     string
+	State    string
+	Reason   string
+	Message  string
+	ExitCode int32
+}
+
+// WorkloadEvent is a status transition of a watched Deployment or Job, or of
+// one of the pods selected by its "app" label. An event with Err set is the
+// last one and reports why the watch couldn't go on, such as its resource
+// version having expired.
+type WorkloadEvent struct {
+	Type              string
+	Kind              string
+	Name              string
+	Namespace         string
+	Replicas          int32
+	ReadyReplicas     int32
+	AvailableReplicas int32
+	Active            int32
+	Succeeded         int32
+	Failed            int32
+	Phase             string
+	Containers        []ContainerState
+	Err               error
+}
+
+func (svc k8sClientService) WatchDeployment(ctx context.Context, namespace, name string) (<-chan WorkloadEvent, error) {
+	client := svc.deploymentsClient(namespace)
+	deployment, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	w, err := retryWatch(deployment.ResourceVersion, nameSelector(name), client.Watch)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.watchWorkload(ctx, namespace, name, deployment, w)
+}
+
+func (svc k8sClientService) WatchJob(ctx context.Context, namespace, name string) (<-chan WorkloadEvent, error) {
+	client := svc.jobsClient(namespace)
+	job, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	w, err := retryWatch(job.ResourceVersion, nameSelector(name), client.Watch)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.watchWorkload(ctx, namespace, name, job, w)
+}
+
+// watchWorkload reports the current workload and its pods as added, then
+// merges the workload watch with a watch on its pods and forwards the
+// resulting events until ctx is done or either watch ends.
+func (svc k8sClientService) watchWorkload(ctx context.Context, namespace, name string, current runtime.Object, workload watch.Interface) (<-chan WorkloadEvent, error) {
+	podsClient := svc.clientSet.CoreV1().Pods(svc.namespace(namespace))
+	selector := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{"app": name}).String(),
+	}
+
+	list, err := podsClient.List(selector)
+	if err != nil {
+		workload.Stop()
+		return nil, translateError(err)
+	}
+
+	pods, err := retryWatch(list.ResourceVersion, selector, podsClient.Watch)
+	if err != nil {
+		workload.Stop()
+		return nil, err
+	}
+
+	initial := []watch.Event{{Type: watch.Added, Object: current}}
+	for i := range list.Items {
+		initial = append(initial, watch.Event{Type: watch.Added, Object: &list.Items[i]})
+	}
+
+	events := make(chan WorkloadEvent)
+	go func() {
+		defer close(events)
+		defer workload.Stop()
+		defer pods.Stop()
+
+		for _, e := range initial {
+			event, _ := toWorkloadEvent(e)
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for {
+			var e watch.Event
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok = <-workload.ResultChan():
+			case e, ok = <-pods.ResultChan():
+			}
+			if !ok {
+				return
+			}
+
+			event, ok := toWorkloadEvent(e)
+			if !ok {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+
+			if event.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// retryWatch watches the objects matching selector from resourceVersion on,
+// resuming from the last event received whenever the API server closes the
+// watch. It ends only once the resource version is too old to resume from.
+func retryWatch(resourceVersion string, selector metav1.ListOptions, watchFunc cache.WatchFunc) (watch.Interface, error) {
+	return watchtools.NewRetryWatcher(resourceVersion, &cache.ListWatch{
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = selector.LabelSelector
+			opts.FieldSelector = selector.FieldSelector
+			return watchFunc(opts)
+		},
+	})
+}
+
+func nameSelector(name string) metav1.ListOptions {
+	return metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+	}
+}
+
+func toWorkloadEvent(e watch.Event) (WorkloadEvent, bool) {
+	switch obj := e.Object.(type) {
+	case *appsv1.Deployment:
+		event := WorkloadEvent{
+			Type:              string(e.Type),
+			Kind:              KindDeployment,
+			Name:              obj.Name,
+			Namespace:         obj.Namespace,
+			ReadyReplicas:     obj.Status.ReadyReplicas,
+			AvailableReplicas: obj.Status.AvailableReplicas,
+		}
+		if obj.Spec.Replicas != nil {
+			event.Replicas = *obj.Spec.Replicas
+		}
+		return event, true
+	case *batchv1.Job:
+		event := WorkloadEvent{
+			Type:      string(e.Type),
+			Kind:      KindJob,
+			Name:      obj.Name,
+			Namespace: obj.Namespace,
+			Active:    obj.Status.Active,
+			Succeeded: obj.Status.Succeeded,
+			Failed:    obj.Status.Failed,
+		}
+		for _, c := range obj.Status.Conditions {
+			if c.Status == apiv1.ConditionTrue {
+				event.Phase = string(c.Type)
+			}
+		}
+		return event, true
+	case *apiv1.Pod:
+		event := WorkloadEvent{
+			Type:      string(e.Type),
+			Kind:      KindPod,
+			Name:      obj.Name,
+			Namespace: obj.Namespace,
+			Phase:     string(obj.Status.Phase),
+		}
+		for _, cs := range obj.Status.ContainerStatuses {
+			event.Containers = append(event.Containers, toContainerState(cs))
+		}
+		return event, true
+	case *metav1.Status:
+		if e.Type != watch.Error {
+			return WorkloadEvent{}, false
+		}
+		return WorkloadEvent{Type: string(e.Type), Err: watchError(*obj)}, true
+	default:
+		return WorkloadEvent{}, false
+	}
+}
+
+// watchError translates the status ending a watch. Statuses without a coded
+// counterpart, like an expired resource version, mean the watch has to be
+// started over.
+func watchError(status metav1.Status) error {
+	err := translateError(&k8sErrors.StatusError{ErrStatus: status})
+	if _, ok := err.(*errors.Error); ok {
+		return err
+	}
+
+	return errors.New(errors.Unavailable, fmt.Sprintf("watch ended: %s", status.Message))
+}
+
+func toContainerState(cs apiv1.ContainerStatus) ContainerState {
+	state := ContainerState{Name: cs.Name}
+
+	switch {
+	case cs.State.Waiting != nil:
+		state.State = "Waiting"
+		state.Reason = cs.State.Waiting.Reason
+		state.Message = cs.State.Waiting.Message
+	case cs.State.Running != nil:
+		state.State = "Running"
+	case cs.State.Terminated != nil:
+		state.State = "Terminated"
+		state.Reason = cs.State.Terminated.Reason
+		state.Message = cs.State.Terminated.Message
+		state.ExitCode = cs.State.Terminated.ExitCode
+	}
+
+	return state
+}
@@ -78,6 +78,69 @@ func (m *MountedPersistentVolumeClaim) GetMountPath() string {
 	return ""
 }
 
+type MountedConfig struct {
+	SecretName           string   `protobuf:"bytes,1,opt,name=SecretName,json=secretName,proto3" json:"SecretName,omitempty"`
+	ConfigMapName        string   `protobuf:"bytes,2,opt,name=ConfigMapName,json=configMapName,proto3" json:"ConfigMapName,omitempty"`
+	MountPath            string   `protobuf:"bytes,3,opt,name=MountPath,json=mountPath,proto3" json:"MountPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MountedConfig) Reset()         { *m = MountedConfig{} }
+func (m *MountedConfig) String() string { return proto.CompactTextString(m) }
+func (*MountedConfig) ProtoMessage()    {}
+func (*MountedConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{1}
+}
+func (m *MountedConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MountedConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MountedConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MountedConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MountedConfig.Merge(m, src)
+}
+func (m *MountedConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MountedConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MountedConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MountedConfig proto.InternalMessageInfo
+
+func (m *MountedConfig) GetSecretName() string {
+	if m != nil {
+		return m.SecretName
+	}
+	return ""
+}
+
+func (m *MountedConfig) GetConfigMapName() string {
+	if m != nil {
+		return m.ConfigMapName
+	}
+	return ""
+}
+
+func (m *MountedConfig) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
+	}
+	return ""
+}
+
 type TrainingReq struct {
 	Name                 string                        `protobuf:"bytes,1,opt,name=Name,json=name,proto3" json:"Name,omitempty"`
 	Image                string                        `protobuf:"bytes,2,opt,name=Image,json=image,proto3" json:"Image,omitempty"`
@@ -86,6 +149,10 @@ type TrainingReq struct {
 	GPU                  uint64                        `protobuf:"varint,5,opt,name=GPU,json=gPU,proto3" json:"GPU,omitempty"`
 	Command              []string                      `protobuf:"bytes,6,rep,name=Command,json=command,proto3" json:"Command,omitempty"`
 	Arguments            []string                      `protobuf:"bytes,7,rep,name=Arguments,json=arguments,proto3" json:"Arguments,omitempty"`
+	Env                  []*EnvVar                     `protobuf:"bytes,8,rep,name=Env,json=env,proto3" json:"Env,omitempty"`
+	EnvFrom              []*EnvFromSource              `protobuf:"bytes,9,rep,name=EnvFrom,json=envFrom,proto3" json:"EnvFrom,omitempty"`
+	Configs              []*MountedConfig              `protobuf:"bytes,10,rep,name=Configs,json=configs,proto3" json:"Configs,omitempty"`
+	ImagePullSecrets     []string                      `protobuf:"bytes,11,rep,name=ImagePullSecrets,json=imagePullSecrets,proto3" json:"ImagePullSecrets,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -95,7 +162,7 @@ func (m *TrainingReq) Reset()         { *m = TrainingReq{} }
 func (m *TrainingReq) String() string { return proto.CompactTextString(m) }
 func (*TrainingReq) ProtoMessage()    {}
 func (*TrainingReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5431a010549573, []int{1}
+	return fileDescriptor_0b5431a010549573, []int{2}
 }
 func (m *TrainingReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,6 +240,34 @@ func (m *TrainingReq) GetArguments() []string {
 	return nil
 }
 
+func (m *TrainingReq) GetEnv() []*EnvVar {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *TrainingReq) GetEnvFrom() []*EnvFromSource {
+	if m != nil {
+		return m.EnvFrom
+	}
+	return nil
+}
+
+func (m *TrainingReq) GetConfigs() []*MountedConfig {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
+func (m *TrainingReq) GetImagePullSecrets() []string {
+	if m != nil {
+		return m.ImagePullSecrets
+	}
+	return nil
+}
+
 type Training struct {
 	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -184,7 +279,7 @@ func (m *Training) Reset()         { *m = Training{} }
 func (m *Training) String() string { return proto.CompactTextString(m) }
 func (*Training) ProtoMessage()    {}
 func (*Training) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5431a010549573, []int{2}
+	return fileDescriptor_0b5431a010549573, []int{3}
 }
 func (m *Training) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,6 +317,7 @@ func (m *Training) GetValue() string {
 
 func init() {
 	proto.RegisterType((*MountedPersistentVolumeClaim)(nil), "quai.MountedPersistentVolumeClaim")
+	proto.RegisterType((*MountedConfig)(nil), "quai.MountedConfig")
 	proto.RegisterType((*TrainingReq)(nil), "quai.TrainingReq")
 	proto.RegisterType((*Training)(nil), "quai.Training")
 }
@@ -229,28 +325,36 @@ func init() {
 func init() { proto.RegisterFile("models.proto", fileDescriptor_0b5431a010549573) }
 
 var fileDescriptor_0b5431a010549573 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x4e, 0xf3, 0x40,
-	0x10, 0x85, 0xb3, 0xbf, 0xed, 0xf8, 0xf7, 0x24, 0xa0, 0xb0, 0xa2, 0x58, 0xa1, 0xc8, 0xb2, 0x5c,
-	0xa5, 0x4a, 0x11, 0x28, 0x28, 0x68, 0x20, 0x91, 0x10, 0x45, 0x90, 0xe5, 0x90, 0xf4, 0x4b, 0x3c,
-	0x32, 0x2b, 0x79, 0xd7, 0x89, 0xbd, 0xce, 0x59, 0x38, 0x12, 0x25, 0x47, 0x40, 0xe1, 0x14, 0x74,
-	0x68, 0x6d, 0x8c, 0x42, 0x83, 0x28, 0xdf, 0x1b, 0xbd, 0xd1, 0xfb, 0x66, 0xa0, 0x2f, 0xf3, 0x04,
-	0xb3, 0x72, 0xbc, 0x29, 0x72, 0x9d, 0x53, 0x7b, 0x5b, 0x71, 0x11, 0xae, 0x60, 0x38, 0xcf, 0x2b,
-	0xa5, 0x31, 0x89, 0xb0, 0x28, 0x45, 0xa9, 0x51, 0xe9, 0x55, 0x9e, 0x55, 0x12, 0xa7, 0x19, 0x17,
-	0x92, 0x32, 0x70, 0xa3, 0xd5, 0xf4, 0x9e, 0x4b, 0x64, 0x24, 0x20, 0x23, 0x2f, 0x76, 0x37, 0x8d,
-	0xa4, 0x43, 0xf0, 0xea, 0x64, 0xc4, 0xf5, 0x13, 0xfb, 0x57, 0xcf, 0x3c, 0xd9, 0x1a, 0xe1, 0x07,
-	0x81, 0xde, 0x43, 0xc1, 0x85, 0x12, 0x2a, 0x8d, 0x71, 0x4b, 0x29, 0xd8, 0x07, 0x4b, 0x6c, 0x65,
-	0x36, 0x9c, 0x82, 0x73, 0x27, 0x79, 0x8a, 0x5f, 0x69, 0x47, 0x18, 0x41, 0xaf, 0xc0, 0x9d, 0x71,
-	0xcd, 0x17, 0xa8, 0x99, 0x15, 0x90, 0x51, 0x6f, 0x12, 0x8e, 0x4d, 0xd3, 0xf1, 0x6f, 0x35, 0x63,
-	0x37, 0x69, 0x22, 0xf4, 0x12, 0x9c, 0xb9, 0xa1, 0x64, 0xf6, 0x9f, 0xb3, 0x4e, 0x7d, 0x16, 0x3a,
-	0x00, 0xeb, 0x36, 0x5a, 0x32, 0x27, 0x20, 0x23, 0x3b, 0xb6, 0xd2, 0x68, 0x69, 0xd8, 0xa7, 0xb9,
-	0x94, 0x5c, 0x25, 0xac, 0x1b, 0x58, 0x86, 0x7d, 0xdd, 0x48, 0xc3, 0x7e, 0x5d, 0xa4, 0x95, 0x44,
-	0xa5, 0x4b, 0xe6, 0xd6, 0x33, 0x8f, 0xb7, 0x46, 0x18, 0xc0, 0xff, 0x16, 0xdd, 0x30, 0xee, 0x78,
-	0x56, 0xb5, 0xe0, 0x8d, 0x98, 0xcc, 0xa0, 0x5f, 0xb7, 0x5c, 0x60, 0xb1, 0x13, 0x6b, 0xa4, 0x17,
-	0x70, 0xb4, 0xd0, 0xbc, 0xd0, 0xdf, 0xb1, 0x93, 0xa6, 0xf7, 0xc1, 0x05, 0xcf, 0x8e, 0x7f, 0x5a,
-	0x61, 0xe7, 0x66, 0xf0, 0xb2, 0xf7, 0xc9, 0xeb, 0xde, 0x27, 0x6f, 0x7b, 0x9f, 0x3c, 0xbf, 0xfb,
-	0x9d, 0xc7, 0x6e, 0xfd, 0xda, 0xf3, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0x45, 0x3f, 0x71,
-	0xea, 0x01, 0x00, 0x00,
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x8e, 0xd3, 0x3c,
+	0x18, 0xdc, 0xfc, 0x49, 0x36, 0x9b, 0xaf, 0xed, 0x4f, 0x31, 0x1c, 0xac, 0xd5, 0x2a, 0x8a, 0x22,
+	0x0e, 0x15, 0x12, 0x3d, 0x14, 0x0e, 0x7b, 0xe0, 0x02, 0xd9, 0x82, 0x38, 0x14, 0x45, 0x09, 0xdb,
+	0xbb, 0x49, 0x4d, 0xb0, 0x88, 0x9d, 0xae, 0xe3, 0xe4, 0x59, 0xb8, 0xf3, 0x32, 0x1c, 0x79, 0x04,
+	0x54, 0x5e, 0x04, 0xd9, 0x26, 0x55, 0xba, 0x07, 0xc4, 0x71, 0xc6, 0xf3, 0xcd, 0xf7, 0x65, 0x26,
+	0x30, 0xe5, 0xcd, 0x8e, 0xd6, 0xed, 0x72, 0x2f, 0x1b, 0xd5, 0x20, 0xef, 0xae, 0x23, 0xec, 0xf2,
+	0xc1, 0x97, 0xeb, 0x36, 0xad, 0x19, 0x15, 0xca, 0xd2, 0xc9, 0x16, 0xae, 0x36, 0x4d, 0x27, 0x14,
+	0xdd, 0x65, 0x54, 0xb6, 0xac, 0x55, 0x54, 0xa8, 0x6d, 0x53, 0x77, 0x9c, 0xa6, 0x35, 0x61, 0x1c,
+	0x61, 0x08, 0xb2, 0x6d, 0xfa, 0x9e, 0x70, 0x8a, 0x9d, 0xd8, 0x59, 0x84, 0x79, 0xb0, 0xb7, 0x10,
+	0x5d, 0x41, 0x68, 0x26, 0x33, 0xa2, 0x3e, 0xe3, 0xff, 0xcc, 0x5b, 0xc8, 0x07, 0x22, 0x69, 0x61,
+	0xf6, 0xc7, 0x37, 0x6d, 0xc4, 0x27, 0x56, 0xa1, 0x08, 0xa0, 0xa0, 0xa5, 0xa4, 0x6a, 0xe4, 0x05,
+	0xed, 0x91, 0x41, 0x4f, 0x60, 0x66, 0x95, 0x1b, 0xb2, 0x37, 0x12, 0x6b, 0x39, 0x2b, 0xc7, 0xe4,
+	0xe9, 0x52, 0xf7, 0xfe, 0xd2, 0x6f, 0x2e, 0x4c, 0x3e, 0x48, 0xc2, 0x04, 0x13, 0x55, 0x4e, 0xef,
+	0x10, 0x02, 0x6f, 0xb4, 0xcd, 0x13, 0xda, 0xe1, 0x31, 0xf8, 0xef, 0x38, 0xa9, 0x06, 0x7f, 0x9f,
+	0x69, 0x80, 0x5e, 0x42, 0x70, 0x43, 0x14, 0x29, 0xa8, 0x32, 0xae, 0x93, 0x55, 0xb2, 0xd4, 0x79,
+	0x2d, 0xff, 0x96, 0x4d, 0x1e, 0xec, 0xec, 0x08, 0xba, 0x06, 0x7f, 0xa3, 0xb3, 0xc6, 0xde, 0x3f,
+	0xcf, 0xfa, 0xa6, 0x1c, 0x34, 0x07, 0xf7, 0x6d, 0x76, 0x8b, 0xfd, 0xd8, 0x59, 0x78, 0xb9, 0x5b,
+	0x65, 0xb7, 0x3a, 0xf0, 0xb4, 0xe1, 0x9c, 0x88, 0x1d, 0x3e, 0x8f, 0x5d, 0x1d, 0x78, 0x69, 0xa1,
+	0xfe, 0xf6, 0x57, 0xb2, 0xea, 0x38, 0x15, 0xaa, 0xc5, 0x81, 0x79, 0x0b, 0xc9, 0x40, 0xa0, 0x08,
+	0xdc, 0xb5, 0xe8, 0xf1, 0x45, 0xec, 0x2e, 0x26, 0xab, 0xa9, 0xbd, 0x60, 0x2d, 0xfa, 0x2d, 0x91,
+	0xb9, 0x4b, 0x45, 0x8f, 0x9e, 0x41, 0xb0, 0x16, 0xfd, 0x1b, 0xd9, 0x70, 0x1c, 0x1a, 0xcd, 0xa3,
+	0xa3, 0x46, 0x93, 0x45, 0xd3, 0xc9, 0x92, 0xe6, 0x01, 0xb5, 0x50, 0xcb, 0x6d, 0x1d, 0x2d, 0x86,
+	0xb1, 0xfc, 0xa4, 0x54, 0x7d, 0x9b, 0xd1, 0xa0, 0xa7, 0x30, 0x37, 0xa9, 0x66, 0x5d, 0x5d, 0xdb,
+	0x9a, 0x5b, 0x3c, 0x31, 0x27, 0xce, 0xd9, 0x3d, 0x3e, 0x89, 0xe1, 0x62, 0x28, 0x49, 0xb7, 0xd1,
+	0x93, 0xba, 0x1b, 0x2a, 0xb2, 0x60, 0x75, 0x03, 0x53, 0x93, 0x67, 0x41, 0x65, 0xcf, 0x4a, 0x8a,
+	0x5e, 0xc0, 0xac, 0x50, 0x44, 0xaa, 0xe3, 0xd8, 0x43, 0x7b, 0xcc, 0xa8, 0xeb, 0xcb, 0xff, 0x4f,
+	0xa9, 0xe4, 0xec, 0xf5, 0xfc, 0xfb, 0x21, 0x72, 0x7e, 0x1c, 0x22, 0xe7, 0xe7, 0x21, 0x72, 0xbe,
+	0xfe, 0x8a, 0xce, 0x3e, 0x9e, 0x9b, 0x7f, 0xfe, 0xf9, 0xef, 0x01, 0x00, 0xee, 0x78, 0xa0, 0x4c,
+	0x1a, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -358,6 +462,45 @@ func (m *MountedPersistentVolumeClaim) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MountedConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MountedConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecretName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SecretName)))
+		i += copy(dAtA[i:], m.SecretName)
+	}
+	if len(m.ConfigMapName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ConfigMapName)))
+		i += copy(dAtA[i:], m.ConfigMapName)
+	}
+	if len(m.MountPath) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.MountPath)))
+		i += copy(dAtA[i:], m.MountPath)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *TrainingReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -440,6 +583,57 @@ func (m *TrainingReq) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.Env) > 0 {
+		for _, msg := range m.Env {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintModels(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.EnvFrom) > 0 {
+		for _, msg := range m.EnvFrom {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintModels(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Configs) > 0 {
+		for _, msg := range m.Configs {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintModels(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for _, s := range m.ImagePullSecrets {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -502,6 +696,30 @@ func (m *MountedPersistentVolumeClaim) Size() (n int) {
 	return n
 }
 
+func (m *MountedConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SecretName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ConfigMapName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.MountPath)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TrainingReq) Size() (n int) {
 	if m == nil {
 		return 0
@@ -539,6 +757,30 @@ func (m *TrainingReq) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.EnvFrom) > 0 {
+		for _, e := range m.EnvFrom {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for _, s := range m.ImagePullSecrets {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -692,6 +934,156 @@ func (m *MountedPersistentVolumeClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MountedConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MountedConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MountedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TrainingReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -940,6 +1332,140 @@ func (m *TrainingReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Arguments = append(m.Arguments, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, &EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvFrom = append(m.EnvFrom, &EnvFromSource{})
+			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, &MountedConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
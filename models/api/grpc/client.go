@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/errors"
 	"github.com/hykuan/k8s-client-example/models"
 )
 
@@ -21,14 +22,23 @@ func NewClient(conn *grpc.ClientConn) quai.ModelServiceClient {
 	svcName := "quai.ModelService"
 
 	return &grpcClient{
-		startTraining: kitgrpc.NewClient(
+		startTraining: decodeErrors(kitgrpc.NewClient(
 			conn,
 			svcName,
 			"StartTraining",
 			encodeStartTrainingRequest,
 			decodeStartTrainingResponse,
 			quai.PersistentVolumeName{},
-		).Endpoint(),
+		).Endpoint()),
+	}
+}
+
+// decodeErrors turns the gRPC status errors returned by e back into the
+// errors the server encoded, so that callers can tell them apart by code.
+func decodeErrors(e endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		res, err := e(ctx, request)
+		return res, errors.FromStatus(err)
 	}
 }
 
@@ -76,6 +86,11 @@ func encodeStartTrainingRequest(_ context.Context, grpcReq interface{}) (interfa
 		GPU:       req.training.GPU,
 		Command:   req.training.Command,
 		Arguments: req.training.Arguments,
+		Env:       toEnvMessages(req.training.Env),
+		EnvFrom:   toEnvFromMessages(req.training.EnvFrom),
+		Configs:   toConfigMessages(req.training.Configs),
+
+		ImagePullSecrets: req.training.ImagePullSecrets,
 	}, nil
 }
 
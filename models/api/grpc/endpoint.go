@@ -14,7 +14,7 @@ func startTrainingEndpoint(svc models.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		trainingName, err := svc.StartTraining(models.Training{
+		trainingName, err := svc.StartTraining(ctx, models.Training{
 			Name:  req.training.Name,
 			Image: req.training.Image,
 			DataSet: &models.MountedPersistentVolumeClaim{
@@ -1,6 +1,109 @@
 package grpc
 
+import (
+	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/models"
+)
+
 type trainingRes struct {
 	name string
 	err  error
 }
+
+func toKeySelectorMessage(s *models.KeySelector) *quai.KeySelector {
+	if s == nil {
+		return nil
+	}
+
+	return &quai.KeySelector{Name: s.Name, Key: s.Key, Optional: s.Optional}
+}
+
+func fromKeySelectorMessage(s *quai.KeySelector) *models.KeySelector {
+	if s == nil {
+		return nil
+	}
+
+	return &models.KeySelector{Name: s.Name, Key: s.Key, Optional: s.Optional}
+}
+
+func toEnvMessages(env []*models.EnvVar) []*quai.EnvVar {
+	var res []*quai.EnvVar
+	for _, e := range env {
+		res = append(res, &quai.EnvVar{
+			Name:            e.Name,
+			Value:           e.Value,
+			SecretKeyRef:    toKeySelectorMessage(e.SecretKeyRef),
+			ConfigMapKeyRef: toKeySelectorMessage(e.ConfigMapKeyRef),
+		})
+	}
+
+	return res
+}
+
+func fromEnvMessages(env []*quai.EnvVar) []*models.EnvVar {
+	var res []*models.EnvVar
+	for _, e := range env {
+		res = append(res, &models.EnvVar{
+			Name:            e.Name,
+			Value:           e.Value,
+			SecretKeyRef:    fromKeySelectorMessage(e.SecretKeyRef),
+			ConfigMapKeyRef: fromKeySelectorMessage(e.ConfigMapKeyRef),
+		})
+	}
+
+	return res
+}
+
+func toEnvFromMessages(sources []*models.EnvFromSource) []*quai.EnvFromSource {
+	var res []*quai.EnvFromSource
+	for _, s := range sources {
+		res = append(res, &quai.EnvFromSource{
+			Prefix:        s.Prefix,
+			SecretName:    s.SecretName,
+			ConfigMapName: s.ConfigMapName,
+			Optional:      s.Optional,
+		})
+	}
+
+	return res
+}
+
+func fromEnvFromMessages(sources []*quai.EnvFromSource) []*models.EnvFromSource {
+	var res []*models.EnvFromSource
+	for _, s := range sources {
+		res = append(res, &models.EnvFromSource{
+			Prefix:        s.Prefix,
+			SecretName:    s.SecretName,
+			ConfigMapName: s.ConfigMapName,
+			Optional:      s.Optional,
+		})
+	}
+
+	return res
+}
+
+func toConfigMessages(configs []*models.MountedConfig) []*quai.MountedConfig {
+	var res []*quai.MountedConfig
+	for _, c := range configs {
+		res = append(res, &quai.MountedConfig{
+			SecretName:    c.SecretName,
+			ConfigMapName: c.ConfigMapName,
+			MountPath:     c.MountPath,
+		})
+	}
+
+	return res
+}
+
+func fromConfigMessages(configs []*quai.MountedConfig) []*models.MountedConfig {
+	var res []*models.MountedConfig
+	for _, c := range configs {
+		res = append(res, &models.MountedConfig{
+			SecretName:    c.SecretName,
+			ConfigMapName: c.ConfigMapName,
+			MountPath:     c.MountPath,
+		})
+	}
+
+	return res
+}
@@ -10,10 +10,9 @@ package grpc
 import (
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/errors"
 	"github.com/hykuan/k8s-client-example/models"
 )
 
@@ -66,6 +65,11 @@ func decodeTrainingRequest(_ context.Context, grpcReq interface{}) (interface{},
 			GPU:       req.GPU,
 			Command:   req.Command,
 			Arguments: req.Arguments,
+			Env:       fromEnvMessages(req.Env),
+			EnvFrom:   fromEnvFromMessages(req.EnvFrom),
+			Configs:   fromConfigMessages(req.Configs),
+
+			ImagePullSecrets: req.ImagePullSecrets,
 		},
 	}, nil
 }
@@ -76,16 +80,5 @@ func encodeTrainingResponse(_ context.Context, grpcRes interface{}) (interface{}
 }
 
 func encodeError(err error) error {
-	if err == nil {
-		return nil
-	}
-
-	switch err {
-	case models.ErrMalformedEntity:
-		return status.Error(codes.InvalidArgument, "received invalid token request")
-	case models.ErrUnauthorizedAccess:
-		return status.Error(codes.Unauthenticated, "failed to identify user from token")
-	default:
-		return status.Error(codes.Internal, "internal server error")
-	}
+	return errors.ToStatus(err)
 }
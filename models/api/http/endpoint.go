@@ -9,14 +9,14 @@ import (
 )
 
 func startTrainingEndpoint(svc models.Service) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(trainingReq)
 
 		if err := req.validate(); err != nil {
 			return nil, err
 		}
 
-		name, err := svc.StartTraining(req.training)
+		name, err := svc.StartTraining(ctx, req.training)
 		return TrainingRes{name}, err
 	}
 }
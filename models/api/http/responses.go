@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/errors"
 )
 
 var (
@@ -25,3 +26,25 @@ func (res TrainingRes) Headers() map[string]string {
 func (res TrainingRes) Empty() bool {
 	return res.Name == ""
 }
+
+// ErrorRes explains why a request was rejected. Code classifies the error
+// and Fields name the fields at fault, if any.
+type ErrorRes struct {
+	Code   errors.Code `json:"code"`
+	Error  string      `json:"error"`
+	Fields []FieldRes  `json:"fields,omitempty"`
+}
+
+type FieldRes struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+func toErrorRes(e *errors.Error) ErrorRes {
+	res := ErrorRes{Code: e.Code, Error: e.Error()}
+	for _, f := range e.Fields {
+		res.Fields = append(res.Fields, FieldRes{Field: f.Field, Reason: f.Reason})
+	}
+
+	return res
+}
@@ -3,7 +3,6 @@ package http
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,9 +10,10 @@ import (
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/go-zoo/bone"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/auth"
+	"github.com/hykuan/k8s-client-example/errors"
 	log "github.com/hykuan/k8s-client-example/logger"
 	"github.com/hykuan/k8s-client-example/models"
 )
@@ -21,22 +21,25 @@ import (
 const contentType = "application/json"
 
 var (
-	errUnsupportedContentType = errors.New("unsupported content type")
+	errUnsupportedContentType = errors.New(errors.Invalid, "unsupported content type")
 	logger                    log.Logger
 )
 
-// MakeHandler returns a HTTP handler for API endpoints.
-func MakeHandler(svc models.Service, l log.Logger) http.Handler {
+// MakeHandler returns a HTTP handler for API endpoints. All endpoints but
+// version and metrics require a bearer token accepted by authn.
+func MakeHandler(svc models.Service, authn auth.Authenticator, l log.Logger) http.Handler {
 	logger = l
 
+	authenticate := auth.Middleware(authn)
 	opts := []kithttp.ServerOption{
+		kithttp.ServerBefore(auth.HTTPToContext),
 		kithttp.ServerErrorEncoder(encodeError),
 	}
 
 	mux := bone.New()
 
 	mux.Post("/training", kithttp.NewServer(
-		startTrainingEndpoint(svc),
+		authenticate(startTrainingEndpoint(svc)),
 		decodeTrainingReq,
 		encodeResponse,
 		opts...,
@@ -82,32 +85,21 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 }
 
 func encodeError(_ context.Context, err error, w http.ResponseWriter) {
-	w.Header().Set("Content-Type", contentType)
+	switch err.(type) {
+	case *json.SyntaxError, *json.UnmarshalTypeError:
+		err = models.ErrMalformedEntity
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = models.ErrMalformedEntity
+	}
 
-	switch err {
-	case models.ErrMalformedEntity:
-		w.WriteHeader(http.StatusBadRequest)
-	case models.ErrUnauthorizedAccess:
-		w.WriteHeader(http.StatusForbidden)
-	case models.ErrConflict:
-		w.WriteHeader(http.StatusConflict)
-	case errUnsupportedContentType:
-		w.WriteHeader(http.StatusUnsupportedMediaType)
-	case io.ErrUnexpectedEOF:
-		w.WriteHeader(http.StatusBadRequest)
-	case io.EOF:
-		w.WriteHeader(http.StatusBadRequest)
-	default:
-		if statusError, isStatus := err.(*k8sErrors.StatusError); isStatus {
-			w.WriteHeader(int(statusError.Status().Code))
-		}
-		switch err.(type) {
-		case *json.SyntaxError:
-			w.WriteHeader(http.StatusBadRequest)
-		case *json.UnmarshalTypeError:
-			w.WriteHeader(http.StatusBadRequest)
-		default:
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+	e := errors.From(err)
+	status := e.Code.HTTPStatus()
+	if err == errUnsupportedContentType {
+		status = http.StatusUnsupportedMediaType
 	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(toErrorRes(e))
 }
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -20,7 +21,7 @@ func LoggingMiddleware(svc models.Service, logger log.Logger) models.Service {
 	return &loggingMiddleware{logger, svc}
 }
 
-func (lm *loggingMiddleware) StartTraining(training models.Training) (name string, err error) {
+func (lm *loggingMiddleware) StartTraining(ctx context.Context, training models.Training) (name string, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method register for user %+v took %s to complete", training, time.Since(begin))
 		if err != nil {
@@ -31,5 +32,5 @@ func (lm *loggingMiddleware) StartTraining(training models.Training) (name strin
 
 	}(time.Now())
 
-	return lm.svc.StartTraining(training)
+	return lm.svc.StartTraining(ctx, training)
 }
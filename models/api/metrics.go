@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"time"
 
 	"github.com/go-kit/kit/metrics"
@@ -26,11 +27,11 @@ func MetricsMiddleware(svc models.Service, counter metrics.Counter, latency metr
 	}
 }
 
-func (ms *metricsMiddleware) StartTraining(training models.Training) (name string, err error) {
+func (ms *metricsMiddleware) StartTraining(ctx context.Context, training models.Training) (name string, err error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "register").Add(1)
 		ms.latency.With("method", "register").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return ms.svc.StartTraining(training)
+	return ms.svc.StartTraining(ctx, training)
 }
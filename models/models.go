@@ -5,6 +5,39 @@ type MountedPersistentVolumeClaim struct {
 	MountPath string
 }
 
+// MountedConfig mounts a Secret or a ConfigMap, exactly one of them, as
+// files into the training container.
+type MountedConfig struct {
+	SecretName    string
+	ConfigMapName string
+	MountPath     string
+}
+
+// KeySelector references a key of a Secret or ConfigMap.
+type KeySelector struct {
+	Name     string
+	Key      string
+	Optional bool
+}
+
+// EnvVar is an environment variable set either to Value or to the value of
+// a Secret or ConfigMap key.
+type EnvVar struct {
+	Name            string
+	Value           string
+	SecretKeyRef    *KeySelector
+	ConfigMapKeyRef *KeySelector
+}
+
+// EnvFromSource exposes all keys of a Secret or ConfigMap as environment
+// variables.
+type EnvFromSource struct {
+	Prefix        string
+	SecretName    string
+	ConfigMapName string
+	Optional      bool
+}
+
 type Training struct {
 	Name      string
 	Image     string
@@ -13,6 +46,13 @@ type Training struct {
 	GPU       uint64
 	Command   []string
 	Arguments []string
+	Env       []*EnvVar
+	EnvFrom   []*EnvFromSource
+	Configs   []*MountedConfig
+
+	// ImagePullSecrets names the registry credentials used to pull Image.
+	// When empty, the credentials matching the registry of Image are used.
+	ImagePullSecrets []string
 }
 
 func (t Training) Validate() error {
@@ -24,5 +64,29 @@ func (t Training) Validate() error {
 		return ErrMalformedEntity
 	}
 
+	for _, e := range t.Env {
+		if e == nil || e.Name == "" {
+			return ErrMalformedEntity
+		}
+	}
+
+	for _, s := range t.EnvFrom {
+		if s == nil || (s.SecretName == "") == (s.ConfigMapName == "") {
+			return ErrMalformedEntity
+		}
+	}
+
+	for _, name := range t.ImagePullSecrets {
+		if name == "" {
+			return ErrMalformedEntity
+		}
+	}
+
+	for _, c := range t.Configs {
+		if c == nil || c.MountPath == "" || (c.SecretName == "") == (c.ConfigMapName == "") {
+			return ErrMalformedEntity
+		}
+	}
+
 	return nil
 }
@@ -9,37 +9,45 @@ package models
 
 import (
 	"context"
-	"errors"
 	"strconv"
-	"time"
 
 	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/auth"
+	"github.com/hykuan/k8s-client-example/errors"
 )
 
 var (
 	// ErrConflict indicates usage of the existing email during account
 	// registration.
-	ErrConflict = errors.New("email already taken")
+	ErrConflict = errors.New(errors.AlreadyExists, "email already taken")
 
 	// ErrMalformedEntity indicates malformed entity specification (e.g.
 	// invalid username or password).
-	ErrMalformedEntity = errors.New("malformed entity specification")
+	ErrMalformedEntity = errors.New(errors.Invalid, "malformed entity specification")
 
 	// ErrUnauthorizedAccess indicates missing or invalid credentials provided
 	// when accessing a protected resource.
-	ErrUnauthorizedAccess = errors.New("missing or invalid credentials provided")
+	ErrUnauthorizedAccess = auth.ErrUnauthorizedAccess
 
 	// ErrNotFound indicates a non-existent entity request.
-	ErrNotFound = errors.New("non-existent entity")
+	ErrNotFound = errors.New(errors.NotFound, "non-existent entity")
 
-	// ErrNotFound indicates a non-existent entity request.
-	ErrK8SCreateDeployment = errors.New("create deployment failed")
+	// ErrK8SCanSchedule indicates that the cluster capacity could not be
+	// checked before starting a training.
+	ErrK8SCanSchedule = errors.New(errors.Internal, "scheduling check failed")
+
+	// ErrK8SCreateJob indicates that the training job could not be created.
+	ErrK8SCreateJob = errors.New(errors.Internal, "create job failed")
+
+	// ErrInsufficientResources indicates that no node currently has enough
+	// free resources to run the requested training.
+	ErrInsufficientResources = errors.New(errors.Unavailable, "insufficient cluster resources")
 )
 
 // Service specifies an API that must be fullfiled by the domain service
 // implementation, and all of its decorators (e.g. logging & metrics).
 type Service interface {
-	StartTraining(req Training) (string, error)
+	StartTraining(ctx context.Context, req Training) (string, error)
 }
 
 var _ Service = (*modelsService)(nil)
@@ -55,27 +63,107 @@ func New(k8sClient quai.K8SClientServiceClient) Service {
 	}
 }
 
-func (svc *modelsService) StartTraining(training Training) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
+func (svc *modelsService) StartTraining(ctx context.Context, training Training) (string, error) {
+	resource := &quai.Resource{
+		GPU: strconv.FormatUint(training.GPU, 10),
+	}
+
+	result, err := svc.k8s.CanSchedule(ctx, &quai.DeploymentReq{
+		Name:     training.Name,
+		Image:    training.Image,
+		Resource: resource,
+	})
+	if err != nil {
+		return "", k8sError(err, ErrK8SCanSchedule)
+	}
+
+	if !result.Schedulable {
+		return "", ErrInsufficientResources
+	}
 
-	deployment, err := svc.k8s.CreateDeployment(ctx, &quai.DeploymentReq{
+	volumes := []*quai.VolumeInfo{
+		{Name: training.DataSet.PVCName, PVCName: training.DataSet.PVCName, MountPath: training.DataSet.MountPath},
+		{Name: training.Model.PVCName, PVCName: training.Model.PVCName, MountPath: training.Model.MountPath},
+	}
+	for _, c := range training.Configs {
+		volumes = append(volumes, configVolume(c))
+	}
+
+	job, err := svc.k8s.CreateJob(ctx, &quai.JobReq{
 		Name:      training.Name,
 		Image:     training.Image,
 		Command:   training.Command,
 		Arguments: training.Arguments,
-		Resource: &quai.Resource{
-			GPU: strconv.FormatUint(training.GPU, 10),
-		},
-		Volumes: []*quai.VolumeInfo{
-			{Name: training.DataSet.PVCName, PVCName: training.DataSet.PVCName, MountPath: training.DataSet.MountPath},
-			{Name: training.Model.PVCName, PVCName: training.Model.PVCName, MountPath: training.Model.MountPath},
-		},
-	})
+		Resource:  resource,
+		Volumes:   volumes,
+		Env:       envVars(training.Env),
+		EnvFrom:   envFromSources(training.EnvFrom),
 
+		ImagePullSecrets: training.ImagePullSecrets,
+
+		// Resubmitting a training after a lost response must not fail
+		// because the job was created the first time. Resubmitting it with
+		// a different spec fails, since a job's pods can't be changed.
+		Options: &quai.CreateOptions{Apply: true},
+	})
 	if err != nil {
-		return "", ErrK8SCreateDeployment
+		return "", k8sError(err, ErrK8SCreateJob)
+	}
+
+	return job.Value, nil
+}
+
+// k8sError passes on the errors reported by the k8s-client service, such as
+// an unknown PVC or an invalid image, and hides the internal ones behind
+// internal, the error of the failed step.
+func k8sError(err, internal error) error {
+	if errors.CodeOf(err) == errors.Internal {
+		return internal
+	}
+
+	return err
+}
+
+func configVolume(c *MountedConfig) *quai.VolumeInfo {
+	if c.SecretName != "" {
+		return &quai.VolumeInfo{Name: "secret-" + c.SecretName, SecretName: c.SecretName, MountPath: c.MountPath, ReadOnly: true}
+	}
+
+	return &quai.VolumeInfo{Name: "configmap-" + c.ConfigMapName, ConfigMapName: c.ConfigMapName, MountPath: c.MountPath, ReadOnly: true}
+}
+
+func envVars(env []*EnvVar) []*quai.EnvVar {
+	var vars []*quai.EnvVar
+	for _, e := range env {
+		vars = append(vars, &quai.EnvVar{
+			Name:            e.Name,
+			Value:           e.Value,
+			SecretKeyRef:    keySelector(e.SecretKeyRef),
+			ConfigMapKeyRef: keySelector(e.ConfigMapKeyRef),
+		})
+	}
+
+	return vars
+}
+
+func keySelector(s *KeySelector) *quai.KeySelector {
+	if s == nil {
+		return nil
+	}
+
+	return &quai.KeySelector{Name: s.Name, Key: s.Key, Optional: s.Optional}
+}
+
+func envFromSources(sources []*EnvFromSource) []*quai.EnvFromSource {
+	var res []*quai.EnvFromSource
+	for _, s := range sources {
+		res = append(res, &quai.EnvFromSource{
+			Prefix:        s.Prefix,
+			SecretName:    s.SecretName,
+			ConfigMapName: s.ConfigMapName,
+			Optional:      s.Optional,
+		})
 	}
 
-	return deployment.Value, err
+	return res
 }
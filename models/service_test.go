@@ -0,0 +1,96 @@
+package models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hykuan/k8s-client-example"
+	"github.com/hykuan/k8s-client-example/errors"
+	"github.com/hykuan/k8s-client-example/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// k8sClientStub answers the calls made by StartTraining. The embedded
+// interface is nil, so any other call panics.
+type k8sClientStub struct {
+	quai.K8SClientServiceClient
+
+	schedulable bool
+	scheduleErr error
+	createErr   error
+	jobs        []*quai.JobReq
+}
+
+func (c *k8sClientStub) CanSchedule(_ context.Context, req *quai.DeploymentReq, _ ...grpc.CallOption) (*quai.ScheduleResult, error) {
+	if c.scheduleErr != nil {
+		return nil, c.scheduleErr
+	}
+
+	return &quai.ScheduleResult{Schedulable: c.schedulable}, nil
+}
+
+func (c *k8sClientStub) CreateJob(_ context.Context, req *quai.JobReq, _ ...grpc.CallOption) (*quai.JobName, error) {
+	c.jobs = append(c.jobs, req)
+	if c.createErr != nil {
+		return nil, c.createErr
+	}
+
+	return &quai.JobName{Value: req.Name}, nil
+}
+
+func TestStartTraining(t *testing.T) {
+	training := models.Training{
+		Name:    "mnist",
+		Image:   "tensorflow/tensorflow:latest-gpu",
+		DataSet: &models.MountedPersistentVolumeClaim{PVCName: "mnist-data", MountPath: "/data"},
+		Model:   &models.MountedPersistentVolumeClaim{PVCName: "mnist-model", MountPath: "/model"},
+		GPU:     1,
+	}
+	unknownPVC := errors.New(errors.NotFound, "non-existent entity")
+
+	cases := map[string]struct {
+		client *k8sClientStub
+		name   string
+		err    error
+		jobs   int
+	}{
+		"start schedulable training": {
+			client: &k8sClientStub{schedulable: true},
+			name:   "mnist",
+			jobs:   1,
+		},
+		"start training beyond cluster capacity": {
+			client: &k8sClientStub{schedulable: false},
+			err:    models.ErrInsufficientResources,
+		},
+		"start training when capacity check fails": {
+			client: &k8sClientStub{scheduleErr: errors.New(errors.Internal, "nodes unavailable")},
+			err:    models.ErrK8SCanSchedule,
+		},
+		"start training when job creation fails": {
+			client: &k8sClientStub{schedulable: true, createErr: errors.New(errors.Internal, "etcd unavailable")},
+			err:    models.ErrK8SCreateJob,
+			jobs:   1,
+		},
+		"start training with unknown pvc": {
+			client: &k8sClientStub{schedulable: true, createErr: unknownPVC},
+			err:    unknownPVC,
+			jobs:   1,
+		},
+	}
+
+	for desc, tc := range cases {
+		svc := models.New(tc.client)
+
+		name, err := svc.StartTraining(context.Background(), training)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", desc, tc.err, err))
+		assert.Equal(t, tc.name, name, fmt.Sprintf("%s: wrong job name", desc))
+		assert.Len(t, tc.client.jobs, tc.jobs, fmt.Sprintf("%s: wrong number of created jobs", desc))
+
+		for _, job := range tc.client.jobs {
+			assert.True(t, job.GetOptions().GetApply(), fmt.Sprintf("%s: job not created in apply mode", desc))
+		}
+	}
+}